@@ -3,14 +3,26 @@ package db
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"strings"
+	"time"
 )
 
+// defaultTimeFormat 默认的时间格式（gtime 格式）
+const defaultTimeFormat = "Y-m-d H:i:s"
+
+// QueryHook 查询钩子，在每次执行SQL后调用，可用于记录慢查询等调试信息
+type QueryHook func(ctx context.Context, query string, args []interface{}, duration time.Duration, err error)
+
 // DBManager 数据库管理器
 type DBManager struct {
-	conn        sqlx.SqlConn
-	tablePrefix string // 表前缀
+	conn            sqlx.SqlConn
+	tablePrefix     string    // 表前缀
+	timeFormat      string    // 自动写入时间戳时使用的时间格式
+	queryHook       QueryHook // 查询钩子
+	sqlFetch        bool      // 是否只输出SQL不执行（对 Raw、RawExec 及新建的 Model 生效）
+	safeIdentifiers bool      // 新建的 Model 是否默认开启标识符校验
 }
 
 // NewDBManager 创建数据库管理器
@@ -18,6 +30,7 @@ func NewDBManager(datasource string) *DBManager {
 	return &DBManager{
 		conn:        sqlx.NewSqlConn("mysql", datasource),
 		tablePrefix: "", // 默认无前缀
+		timeFormat:  defaultTimeFormat,
 	}
 }
 
@@ -32,6 +45,45 @@ func (db *DBManager) GetTablePrefix() string {
 	return db.tablePrefix
 }
 
+// SetTimeFormat 设置时间格式（gtime 格式，如 "Y-m-d H:i:s"）
+func (db *DBManager) SetTimeFormat(format string) *DBManager {
+	db.timeFormat = format
+	return db
+}
+
+// GetTimeFormat 获取时间格式
+func (db *DBManager) GetTimeFormat() string {
+	if db.timeFormat == "" {
+		return defaultTimeFormat
+	}
+	return db.timeFormat
+}
+
+// SetSQLFetch 设置是否只输出SQL不执行，对 Raw、RawExec 以及之后新建的 Model 生效
+func (db *DBManager) SetSQLFetch(fetch bool) *DBManager {
+	db.sqlFetch = fetch
+	return db
+}
+
+// SetSafeIdentifiers 设置之后新建的 Model 是否默认开启标识符校验，参见 Model.SafeIdentifiers
+func (db *DBManager) SetSafeIdentifiers(enable bool) *DBManager {
+	db.safeIdentifiers = enable
+	return db
+}
+
+// SetQueryHook 设置查询钩子，Query、QueryRow、Exec 执行后都会调用，传入nil表示取消
+func (db *DBManager) SetQueryHook(hook QueryHook) *DBManager {
+	db.queryHook = hook
+	return db
+}
+
+// callQueryHook 调用查询钩子（未设置时不做任何处理）
+func (db *DBManager) callQueryHook(ctx context.Context, start time.Time, query string, args []interface{}, err error) {
+	if db.queryHook != nil {
+		db.queryHook(ctx, query, args, time.Since(start), err)
+	}
+}
+
 // formatTableName 格式化表名（自动添加前缀）
 func (db *DBManager) formatTableName(table string) string {
 	// 如果表名已经包含前缀，或者前缀为空，直接返回
@@ -55,6 +107,9 @@ func (db *DBManager) Model(table string) *Model {
 		orderBy:  make([]orderClause, 0),
 		page:     1,
 		pageSize: 10,
+		sqlFetch: db.sqlFetch,
+
+		safeIdentifiers: db.safeIdentifiers,
 	}
 }
 
@@ -63,24 +118,175 @@ func (db *DBManager) Table(table string) *Model {
 	return db.Model(table)
 }
 
-// Trans 执行事务
+// ModelTx 创建在事务会话中执行的链式查询构建器，通常在 Trans 的回调中使用
+func (db *DBManager) ModelTx(session sqlx.Session, table string) *Model {
+	return db.Model(table).WithSession(session)
+}
+
+// Trans 执行事务，回调中通过 ModelTx 或 Model.WithSession 创建的查询会在该事务中执行
 func (db *DBManager) Trans(ctx context.Context, fn func(context context.Context, session sqlx.Session) error) error {
 	return db.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
 		return fn(ctx, session)
 	})
 }
 
+// Raw 执行原生SQL查询，结果扫描到 dest 中，SQL中的 {prefix} 会被替换为表前缀
+func (db *DBManager) Raw(ctx context.Context, dest interface{}, query string, args ...interface{}) *QueryResult {
+	query = db.replacePrefix(query)
+
+	// 如果设置了SQLFetch，只输出SQL不执行查询
+	if db.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return &QueryResult{
+			data:  dest,
+			err:   nil,
+			query: query,
+			args:  args,
+		}
+	}
+
+	err := db.Query(ctx, dest, query, args...)
+	return &QueryResult{
+		data:  dest,
+		err:   err,
+		query: query,
+		args:  args,
+	}
+}
+
+// RawExec 执行原生SQL语句，SQL中的 {prefix} 会被替换为表前缀
+// 设置了SQLFetch时只输出SQL，返回的 sql.Result 为 nil
+func (db *DBManager) RawExec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	query = db.replacePrefix(query)
+	if db.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return nil, nil
+	}
+	return db.Exec(ctx, query, args...)
+}
+
+// replacePrefix 将SQL中的 {prefix} 替换为表前缀
+func (db *DBManager) replacePrefix(query string) string {
+	return strings.ReplaceAll(query, "{prefix}", db.tablePrefix)
+}
+
 // Exec 执行SQL语句
 func (db *DBManager) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	return db.conn.ExecCtx(ctx, query, args...)
+	return db.exec(ctx, db.conn, query, args...)
 }
 
 // Query 查询多条记录
 func (db *DBManager) Query(ctx context.Context, v interface{}, query string, args ...interface{}) error {
-	return db.conn.QueryRowsCtx(ctx, v, query, args...)
+	return db.query(ctx, db.conn, v, query, args...)
 }
 
 // QueryRow 查询单条记录
 func (db *DBManager) QueryRow(ctx context.Context, v interface{}, query string, args ...interface{}) error {
-	return db.conn.QueryRowCtx(ctx, v, query, args...)
+	return db.queryRow(ctx, db.conn, v, query, args...)
+}
+
+// Ping 通过连接执行 SELECT 1 检查数据库是否可用，连接异常时返回对应错误，适用于健康检查
+func (db *DBManager) Ping(ctx context.Context) error {
+	var one int
+	return db.conn.QueryRowCtx(ctx, &one, "SELECT 1")
+}
+
+// Stats 返回底层连接池的统计信息（打开连接数、空闲连接数、等待次数等）
+func (db *DBManager) Stats() (sql.DBStats, error) {
+	rawDB, err := db.conn.RawDB()
+	if err != nil {
+		return sql.DBStats{}, err
+	}
+	return rawDB.Stats(), nil
+}
+
+// exec 在指定会话（连接或事务）中执行SQL语句
+func (db *DBManager) exec(ctx context.Context, session sqlx.Session, query string, args ...interface{}) (sql.Result, error) {
+	start := time.Now()
+	result, err := session.ExecCtx(ctx, query, args...)
+	db.callQueryHook(ctx, start, query, args, err)
+	return result, err
+}
+
+// query 在指定会话（连接或事务）中查询多条记录
+func (db *DBManager) query(ctx context.Context, session sqlx.Session, v interface{}, query string, args ...interface{}) error {
+	start := time.Now()
+	err := session.QueryRowsCtx(ctx, v, query, args...)
+	db.callQueryHook(ctx, start, query, args, err)
+	return err
+}
+
+// queryRow 在指定会话（连接或事务）中查询单条记录
+func (db *DBManager) queryRow(ctx context.Context, session sqlx.Session, v interface{}, query string, args ...interface{}) error {
+	start := time.Now()
+	err := session.QueryRowCtx(ctx, v, query, args...)
+	db.callQueryHook(ctx, start, query, args, err)
+	return err
+}
+
+// QueryMaps 查询多条记录，每行扫描为 列名=>值 的map，适用于结果结构不固定的场景
+// NULL 值为 nil，[]byte 类型的列转换为 string
+// 注意：该方法直接使用底层连接池执行，不参与事务，在 Trans 回调中调用时读取不到事务内未提交的数据，
+// 事务中请使用 ModelTx 或 Model.WithSession 扫描到结构体
+func (db *DBManager) QueryMaps(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	start := time.Now()
+	result, err := db.queryMaps(ctx, query, args...)
+	db.callQueryHook(ctx, start, query, args, err)
+	return result, err
+}
+
+// QueryMap 查询单条记录并扫描为 列名=>值 的map，没有记录时返回 sqlx.ErrNotFound
+// 与 QueryMaps 相同，不参与事务
+func (db *DBManager) QueryMap(ctx context.Context, query string, args ...interface{}) (map[string]interface{}, error) {
+	result, err := db.QueryMaps(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, sqlx.ErrNotFound
+	}
+	return result[0], nil
+}
+
+// queryMaps 通过底层 *sql.DB 执行查询并将每行扫描为map
+// sqlx.Session 不支持扫描到map，因此无法在事务会话中执行
+func (db *DBManager) queryMaps(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rawDB, err := db.conn.RawDB()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := rawDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]map[string]interface{}, 0)
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if b, ok := values[i].([]byte); ok {
+				row[column] = string(b)
+			} else {
+				row[column] = values[i]
+			}
+		}
+		result = append(result, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
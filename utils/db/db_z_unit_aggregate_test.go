@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+func TestModel_AggregateFloat(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(m *Model) *QueryResult
+		sql  string
+	}{
+		{"Sum", func(m *Model) *QueryResult { return m.Sum(context.Background(), "amount") }, "SELECT SUM(amount) FROM orders WHERE status = ?"},
+		{"Avg", func(m *Model) *QueryResult { return m.Avg(context.Background(), "amount") }, "SELECT AVG(amount) FROM orders WHERE status = ?"},
+		{"Min", func(m *Model) *QueryResult { return m.Min(context.Background(), "amount") }, "SELECT MIN(amount) FROM orders WHERE status = ?"},
+		{"Max", func(m *Model) *QueryResult { return m.Max(context.Background(), "amount") }, "SELECT MAX(amount) FROM orders WHERE status = ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{scan: func(v any) {
+				*v.(*sql.NullFloat64) = sql.NullFloat64{Float64: 12.5, Valid: true}
+			}}
+			r := tt.run(newTestDB(conn).Model("orders").Where(map[string]interface{}{"status": 1}))
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			query, args := conn.lastQuery()
+			if query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+			if !reflect.DeepEqual(args, []interface{}{1}) {
+				t.Errorf("args = %v, want [1]", args)
+			}
+			if r.data != 12.5 {
+				t.Errorf("result = %v, want 12.5", r.data)
+			}
+
+			// NULL on an empty table becomes 0.
+			conn.scan = func(v any) {
+				*v.(*sql.NullFloat64) = sql.NullFloat64{}
+			}
+			if r = tt.run(newTestDB(conn).Model("orders")); r.GetError() != nil || r.data != float64(0) {
+				t.Errorf("empty table result = %v, %v, want 0", r.data, r.GetError())
+			}
+		})
+	}
+}
+
+func TestModel_AggregateString(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(m *Model) *QueryResult
+		sql  string
+	}{
+		{"MinString", func(m *Model) *QueryResult { return m.MinString(context.Background(), "created_at") }, "SELECT MIN(created_at) FROM user"},
+		{"MaxString", func(m *Model) *QueryResult { return m.MaxString(context.Background(), "created_at") }, "SELECT MAX(created_at) FROM user"},
+		{"GroupConcat", func(m *Model) *QueryResult { return m.GroupConcat(context.Background(), "name", ",") }, "SELECT GROUP_CONCAT(name SEPARATOR ',') FROM user"},
+		{"GroupConcat escaped", func(m *Model) *QueryResult { return m.GroupConcat(context.Background(), "name", `'\`) }, `SELECT GROUP_CONCAT(name SEPARATOR '''\\') FROM user`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{scan: func(v any) {
+				*v.(*sql.NullString) = sql.NullString{String: "value", Valid: true}
+			}}
+			r := tt.run(newTestDB(conn).Model("user"))
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			if query, _ := conn.lastQuery(); query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+			if r.data != "value" {
+				t.Errorf("result = %v, want value", r.data)
+			}
+
+			conn.scan = func(v any) {
+				*v.(*sql.NullString) = sql.NullString{}
+			}
+			if r = tt.run(newTestDB(conn).Model("user")); r.GetError() != nil || r.data != "" {
+				t.Errorf("empty table result = %q, %v, want empty string", r.data, r.GetError())
+			}
+		})
+	}
+}
+
+func TestModel_CountDistinct(t *testing.T) {
+	// The fake database holds user_id values 1, 1, 2, 3, 3, so the distinct count is 3.
+	conn := &fakeConn{scan: func(v any) {
+		distinct := make(map[int]struct{})
+		for _, id := range []int{1, 1, 2, 3, 3} {
+			distinct[id] = struct{}{}
+		}
+		*v.(*int64) = int64(len(distinct))
+	}}
+	r := newTestDB(conn).Model("orders").Where(map[string]interface{}{"status": 1}).
+		CountDistinct(context.Background(), "user_id")
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	if want := "SELECT COUNT(DISTINCT user_id) FROM orders WHERE status = ?"; query != want {
+		t.Errorf("sql = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1}) {
+		t.Errorf("args = %v, want [1]", args)
+	}
+	if r.data != int64(3) {
+		t.Errorf("result = %v, want 3", r.data)
+	}
+}
+
+func TestModel_CountDistinct_JoinGroup(t *testing.T) {
+	conn := &fakeConn{}
+	r := newTestDB(conn).Model("orders").Alias("o").
+		LeftJoin("user", "u", "u.id = o.user_id").
+		Group("o.shop_id").
+		SQLFetch(true).
+		CountDistinct(context.Background(), "o.user_id")
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	want := "SELECT COUNT(DISTINCT o.user_id) FROM orders AS o LEFT JOIN user AS u ON u.id = o.user_id GROUP BY o.shop_id"
+	if r.GetSQL() != want {
+		t.Errorf("sql = %q, want %q", r.GetSQL(), want)
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("SQLFetch should not execute, got %v", conn.queries)
+	}
+	if r.data != int64(0) {
+		t.Errorf("SQLFetch result = %v, want 0", r.data)
+	}
+}
+
+func TestModel_CountDistinct_SafeIdentifiers(t *testing.T) {
+	conn := &fakeConn{}
+	r := newTestDB(conn).Model("orders").SafeIdentifiers(true).
+		CountDistinct(context.Background(), "user_id) FROM user; --")
+	if r.GetError() == nil {
+		t.Error("unsafe field should be rejected")
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("unsafe field should not execute, got %v", conn.queries)
+	}
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_Cursor(t *testing.T) {
+	tests := []struct {
+		name  string
+		model func(db *DBManager) *Model
+		sql   string
+		args  []interface{}
+	}{
+		{
+			name: "after",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").Where(map[string]interface{}{"status": 1}).AfterCursor("id", 100, 20)
+			},
+			sql:  "SELECT * FROM user WHERE status = ? AND id > ? ORDER BY id ASC LIMIT 20",
+			args: []interface{}{1, 100},
+		},
+		{
+			name: "before",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").Where(map[string]interface{}{"status": 1}).BeforeCursor("id", 100, 20)
+			},
+			sql:  "SELECT * FROM user WHERE status = ? AND id < ? ORDER BY id DESC LIMIT 20",
+			args: []interface{}{1, 100},
+		},
+		{
+			name: "first page",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").AfterCursor("id", nil, 10)
+			},
+			sql: "SELECT * FROM user ORDER BY id ASC LIMIT 10",
+		},
+		{
+			name: "replaces prior order and offset",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").OrderByDesc("created_at").Page(3, 10).AfterCursor("id", 100, 20)
+			},
+			sql:  "SELECT * FROM user WHERE id > ? ORDER BY id ASC LIMIT 20",
+			args: []interface{}{100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{}
+			r := tt.model(newTestDB(conn)).Find(context.Background(), &[]map[string]interface{}{})
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			query, args := conn.lastQuery()
+			if query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+			if len(args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(args, tt.args) {
+					t.Errorf("args = %v, want %v", args, tt.args)
+				}
+			}
+		})
+	}
+}
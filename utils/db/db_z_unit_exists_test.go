@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_WhereExists(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	sub := db.Model("orders").Fields("1").Where(map[string]interface{}{"amount": 100})
+	r := db.Model("user").Where(map[string]interface{}{"status": 1}).
+		WhereExists(sub).
+		WhereIn("role", []interface{}{2, 3}).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	want := "SELECT * FROM user WHERE status = ? AND EXISTS (SELECT 1 FROM orders WHERE amount = ?) AND role IN (?,?)"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, 100, 2, 3}) {
+		t.Errorf("args = %v, want [1 100 2 3]", args)
+	}
+}
+
+func TestModel_WhereNotExists(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	sub := db.Model("orders").Fields("1").WhereIn("state", []interface{}{"paid", "sent"})
+	r := db.Model("user").WhereNotExists(sub).Where(map[string]interface{}{"status": 1}).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	want := "SELECT * FROM user WHERE NOT EXISTS (SELECT 1 FROM orders WHERE state IN (?,?)) AND status = ?"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"paid", "sent", 1}) {
+		t.Errorf("args = %v, want [paid sent 1]", args)
+	}
+}
+
+func TestModel_WhereExists_Errors(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	r := db.Model("user").WhereExists(db.Model("orders").SafeIdentifiers(true).Order("id;", "ASC")).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() == nil {
+		t.Error("an error in the subquery should be propagated")
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("failed queries were executed: %v", conn.queries)
+	}
+
+	r = db.Model("user").WhereExists(nil).Find(context.Background(), &[]map[string]interface{}{})
+	if query, _ := conn.lastQuery(); r.GetError() != nil || query != "SELECT * FROM user" {
+		t.Errorf("WhereExists(nil) query = %q, err = %v", query, r.GetError())
+	}
+}
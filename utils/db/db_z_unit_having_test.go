@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_HavingOp(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	r := db.Model("orders").Fields("user_id", "COUNT(*) AS total").
+		Where(map[string]interface{}{"status": 1}).
+		Group("user_id").
+		HavingGt("COUNT(*)", 5).
+		HavingLt("SUM(amount)", 1000).
+		HavingEq("MAX(level)", 3).
+		HavingOp("MIN(amount)", " >= ", 10).
+		Order("total", "DESC").
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	want := "SELECT user_id, COUNT(*) AS total FROM orders WHERE status = ? GROUP BY user_id" +
+		" HAVING COUNT(*) > ? AND SUM(amount) < ? AND MAX(level) = ? AND MIN(amount) >= ?" +
+		" ORDER BY total DESC"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, 5, 1000, 3, 10}) {
+		t.Errorf("args = %v, want [1 5 1000 3 10]", args)
+	}
+}
+
+func TestModel_HavingOp_Errors(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	r := db.Model("orders").Group("user_id").HavingOp("COUNT(*)", "LIKE", 1).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() == nil {
+		t.Error("unsupported operator should fail")
+	}
+
+	r = db.Model("orders").SafeIdentifiers(true).Group("user_id").HavingGt("COUNT(*) OR 1=1", 1).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() == nil {
+		t.Error("unsafe having field should fail with SafeIdentifiers")
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("failed queries were executed: %v", conn.queries)
+	}
+}
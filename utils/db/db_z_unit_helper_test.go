@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// fakeConn 记录执行的SQL和参数的测试连接，未覆盖的方法调用会panic
+type fakeConn struct {
+	sqlx.SqlConn
+	queries  []string
+	args     [][]interface{}
+	err      error        // 所有查询和执行返回的错误
+	affected int64        // ExecCtx 返回的受影响行数
+	insertID int64        // ExecCtx 返回的自增ID
+	scan     func(v any)  // 查询成功时用于填充结果
+	rawDB    *sql.DB      // RawDB 返回的连接
+	session  sqlx.Session // TransactCtx 传给回调的会话，为空时使用自身
+}
+
+func (c *fakeConn) record(query string, args []interface{}) {
+	c.queries = append(c.queries, query)
+	c.args = append(c.args, args)
+}
+
+func (c *fakeConn) ExecCtx(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	c.record(query, args)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return fakeResult{insertID: c.insertID, affected: c.affected}, nil
+}
+
+// fakeResult 返回固定自增ID和受影响行数的执行结果
+type fakeResult struct {
+	insertID int64
+	affected int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return r.insertID, nil }
+
+func (r fakeResult) RowsAffected() (int64, error) { return r.affected, nil }
+
+func (c *fakeConn) QueryRowCtx(ctx context.Context, v any, query string, args ...any) error {
+	c.record(query, args)
+	if c.err == nil && c.scan != nil {
+		c.scan(v)
+	}
+	return c.err
+}
+
+func (c *fakeConn) QueryRowsCtx(ctx context.Context, v any, query string, args ...any) error {
+	return c.QueryRowCtx(ctx, v, query, args...)
+}
+
+func (c *fakeConn) RawDB() (*sql.DB, error) {
+	if c.rawDB == nil {
+		return nil, sql.ErrConnDone
+	}
+	return c.rawDB, nil
+}
+
+func (c *fakeConn) TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error {
+	if c.session != nil {
+		return fn(ctx, c.session)
+	}
+	return fn(ctx, c)
+}
+
+// lastQuery 返回最后执行的SQL和参数
+func (c *fakeConn) lastQuery() (string, []interface{}) {
+	if len(c.queries) == 0 {
+		return "", nil
+	}
+	return c.queries[len(c.queries)-1], c.args[len(c.args)-1]
+}
+
+// newTestDB 使用测试连接创建数据库管理器
+func newTestDB(conn *fakeConn) *DBManager {
+	return &DBManager{
+		conn:       conn,
+		timeFormat: defaultTimeFormat,
+	}
+}
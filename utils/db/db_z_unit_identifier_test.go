@@ -0,0 +1,159 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestModel_SafeIdentifiers_Order(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	r := db.Model("user").SafeIdentifiers(true).Order("id; DROP TABLE user", "ASC").Find(ctx, &[]map[string]interface{}{})
+	if r.GetError() == nil || !strings.Contains(r.GetError().Error(), "unsafe order identifier") {
+		t.Errorf("err = %v, want unsafe order identifier", r.GetError())
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("unsafe query was executed: %v", conn.queries)
+	}
+
+	// Without safe mode, the field is passed through unchanged.
+	r = db.Model("user").Order("id; DROP TABLE user", "ASC").Find(ctx, &[]map[string]interface{}{})
+	if r.GetError() != nil || !strings.Contains(r.GetSQL(), "ORDER BY id; DROP TABLE user ASC") {
+		t.Errorf("GetSQL = %q, err = %v", r.GetSQL(), r.GetError())
+	}
+}
+
+func TestModel_SafeIdentifiers_Allowed(t *testing.T) {
+	conn := &fakeConn{}
+	r := newTestDB(conn).Model("user").SafeIdentifiers(true).
+		Alias("u").
+		Fields("u.id", "`name`", "u.*").
+		LeftJoin("role", "r", "r.id = u.role_id AND r.status = ?", 1).
+		WhereIn("u.id", []interface{}{1, 2}).
+		Group("u.id").
+		HavingOp("COUNT(DISTINCT r.id)", ">", 1).
+		Order("u.id", "desc").
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() != nil {
+		t.Fatalf("err = %v", r.GetError())
+	}
+}
+
+func TestModel_SafeIdentifiers_EntryPoints(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		bad     = "id) FROM user; --"
+		newSafe = func() *Model {
+			return newTestDB(&fakeConn{}).Model("user").SafeIdentifiers(true)
+		}
+	)
+	tests := map[string]*QueryResult{
+		"Fields":        newSafe().Fields(bad).Find(ctx, &[]map[string]interface{}{}),
+		"Group":         newSafe().Group(bad).Find(ctx, &[]map[string]interface{}{}),
+		"Join":          newSafe().Join("role", "r", "r.id = u.id OR 1 = 1").Find(ctx, &[]map[string]interface{}{}),
+		"Where map":     newSafe().Where(map[string]interface{}{bad: 1}).Find(ctx, &[]map[string]interface{}{}),
+		"WhereIn":       newSafe().WhereIn(bad, []interface{}{1}).Find(ctx, &[]map[string]interface{}{}),
+		"WhereNull":     newSafe().WhereNull(bad).Find(ctx, &[]map[string]interface{}{}),
+		"HavingOp":      newSafe().HavingOp("SUM(1); --", ">", 1).Find(ctx, &[]map[string]interface{}{}),
+		"CountDistinct": newSafe().CountDistinct(ctx, bad),
+		"Sum":           newSafe().Sum(ctx, bad),
+		"Avg":           newSafe().Avg(ctx, bad),
+		"Min":           newSafe().Min(ctx, bad),
+		"MaxString":     newSafe().MaxString(ctx, bad),
+		"GroupConcat":   newSafe().GroupConcat(ctx, bad, ","),
+		"Value":         newSafe().Value(ctx, bad),
+		"Column":        newSafe().Column(ctx, bad),
+		"Pluck":         newSafe().Pluck(ctx, "id", bad),
+	}
+	for name, r := range tests {
+		if r.GetError() == nil || !strings.Contains(r.GetError().Error(), "unsafe") {
+			t.Errorf("%s: err = %v, want unsafe identifier error", name, r.GetError())
+		}
+	}
+}
+
+func TestModel_SafeIdentifiers_WriteEntryPoints(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		bad  = "x) VALUES (1); DROP TABLE a; --"
+		data = map[string]interface{}{"name": "john"}
+		byID = map[string]interface{}{"id": 1}
+	)
+	tests := []struct {
+		name string
+		run  func(m *Model) *QueryResult
+	}{
+		{"Insert", func(m *Model) *QueryResult {
+			return m.Insert(ctx, map[string]interface{}{bad: 1})
+		}},
+		{"Update", func(m *Model) *QueryResult {
+			return m.Where(byID).Update(ctx, map[string]interface{}{"name = 1, " + bad: 1})
+		}},
+		{"Upsert", func(m *Model) *QueryResult {
+			return m.Upsert(ctx, map[string]interface{}{"id": 1, bad: 1})
+		}},
+		{"UpsertBatch", func(m *Model) *QueryResult {
+			return m.UpsertBatch(ctx, []map[string]interface{}{{"id": 1, bad: 1}})
+		}},
+		{"Upsert updateColumns", func(m *Model) *QueryResult {
+			return m.Upsert(ctx, data, bad)
+		}},
+		{"UpsertKeys", func(m *Model) *QueryResult {
+			return m.UpsertKeys(bad).Upsert(ctx, data)
+		}},
+		{"WithTimestamps created", func(m *Model) *QueryResult {
+			return m.WithTimestamps(bad, "updated_at").Insert(ctx, data)
+		}},
+		{"WithTimestamps updated", func(m *Model) *QueryResult {
+			return m.WithTimestamps("created_at", bad).Where(byID).Update(ctx, data)
+		}},
+		{"SoftDelete", func(m *Model) *QueryResult {
+			return m.SoftDelete(bad).Find(ctx, &[]map[string]interface{}{})
+		}},
+		{"UseIndex", func(m *Model) *QueryResult {
+			return m.UseIndex("i) ; DROP TABLE a; --").Find(ctx, &[]map[string]interface{}{})
+		}},
+		{"ForceIndex", func(m *Model) *QueryResult {
+			return m.ForceIndex("idx_name", "i)").Find(ctx, &[]map[string]interface{}{})
+		}},
+		{"IgnoreIndex", func(m *Model) *QueryResult {
+			return m.IgnoreIndex("i)").Find(ctx, &[]map[string]interface{}{})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{}
+			r := tt.run(newTestDB(conn).SetSafeIdentifiers(true).Model("a"))
+			if r.GetError() == nil || !strings.Contains(r.GetError().Error(), "unsafe") {
+				t.Errorf("err = %v, want unsafe identifier error", r.GetError())
+			}
+			if len(conn.queries) != 0 {
+				t.Errorf("unsafe query was executed: %v", conn.queries)
+			}
+		})
+	}
+}
+
+func TestModel_SafeIdentifiers_WriteAllowed(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		conn = &fakeConn{}
+		db   = newTestDB(conn).SetSafeIdentifiers(true)
+		data = map[string]interface{}{"name": "john", "`order`": 1}
+	)
+	results := map[string]*QueryResult{
+		"Insert": db.Model("a").WithTimestamps("created_at", "").Insert(ctx, data),
+		"Update": db.Model("a").WithTimestamps("", "updated_at").Where(map[string]interface{}{"id": 1}).Update(ctx, data),
+		"Upsert": db.Model("a").UpsertKeys("id", "a.code").Upsert(ctx, data, "name"),
+		"Find":   db.Model("a").SoftDelete("deleted_at").UseIndex("idx_name", "`primary`").Find(ctx, &[]map[string]interface{}{}),
+	}
+	for name, r := range results {
+		if r.GetError() != nil {
+			t.Errorf("%s: err = %v", name, r.GetError())
+		}
+	}
+}
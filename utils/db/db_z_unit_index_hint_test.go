@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+func TestModel_IndexHints(t *testing.T) {
+	tests := []struct {
+		name  string
+		model func(db *DBManager) *Model
+		sql   string
+	}{
+		{
+			name: "single hint",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").UseIndex("idx_status")
+			},
+			sql: "SELECT * FROM user USE INDEX (idx_status)",
+		},
+		{
+			name: "multiple indexes",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").ForceIndex("idx_status", "idx_created_at")
+			},
+			sql: "SELECT * FROM user FORCE INDEX (idx_status, idx_created_at)",
+		},
+		{
+			name: "with alias, join and where",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").Alias("u").UseIndex("idx_status").IgnoreIndex("idx_name").
+					LeftJoin("role", "r", "r.id = u.role_id").
+					Where(map[string]interface{}{"u.status": 1})
+			},
+			sql: "SELECT * FROM user AS u USE INDEX (idx_status) IGNORE INDEX (idx_name) LEFT JOIN role AS r ON r.id = u.role_id WHERE u.status = ?",
+		},
+		{
+			name: "no index is ignored",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").UseIndex()
+			},
+			sql: "SELECT * FROM user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{}
+			r := tt.model(newTestDB(conn)).Find(context.Background(), &[]map[string]interface{}{})
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			if query, _ := conn.lastQuery(); query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+		})
+	}
+}
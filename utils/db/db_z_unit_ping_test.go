@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+func TestDBManager_Ping(t *testing.T) {
+	conn := &fakeConn{scan: func(v any) {
+		*v.(*int) = 1
+	}}
+	if err := newTestDB(conn).Ping(context.Background()); err != nil {
+		t.Fatalf("Ping = %v, want nil", err)
+	}
+	if query, args := conn.lastQuery(); query != "SELECT 1" || len(args) != 0 {
+		t.Errorf("sql = %q, args = %v, want SELECT 1 without args", query, args)
+	}
+}
+
+func TestDBManager_Ping_Error(t *testing.T) {
+	connErr := errors.New("connection refused")
+	conn := &fakeConn{err: connErr}
+	if err := newTestDB(conn).Ping(context.Background()); !errors.Is(err, connErr) {
+		t.Errorf("Ping = %v, want %v", err, connErr)
+	}
+}
+
+func TestDBManager_Stats(t *testing.T) {
+	db := newMapsDB(t, &mapsDriver{})
+	stats, err := db.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 尚未执行查询，连接池中没有打开的连接
+	if stats.OpenConnections != 0 {
+		t.Errorf("OpenConnections = %d, want 0", stats.OpenConnections)
+	}
+
+	// 无法获取底层连接时返回错误
+	if _, err = newTestDB(&fakeConn{}).Stats(); !errors.Is(err, sql.ErrConnDone) {
+		t.Errorf("Stats err = %v, want %v", err, sql.ErrConnDone)
+	}
+}
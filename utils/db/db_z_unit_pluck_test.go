@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_Pluck(t *testing.T) {
+	conn := &fakeConn{scan: func(v any) {
+		*v.(*[]pluckRow) = []pluckRow{
+			{Key: int64(1), Value: []byte("admin")},
+			{Key: int64(2), Value: []byte("editor")},
+			{Key: []byte("guest"), Value: nil},
+			{Key: int64(2), Value: []byte("author")},
+		}
+	}}
+	r := newTestDB(conn).Model("role").Where(map[string]interface{}{"status": 1}).
+		Pluck(context.Background(), "id", "name")
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	if want := "SELECT id AS pluck_key, name AS pluck_value FROM role WHERE status = ?"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1}) {
+		t.Errorf("args = %v, want [1]", args)
+	}
+	want := map[interface{}]interface{}{
+		int64(1): "admin",
+		int64(2): "author",
+		"guest":  nil,
+	}
+	if !reflect.DeepEqual(r.data, want) {
+		t.Errorf("Pluck = %#v, want %#v", r.data, want)
+	}
+}
+
+func TestModel_PluckColumn(t *testing.T) {
+	conn := &fakeConn{scan: func(v any) {
+		*v.(*[]interface{}) = []interface{}{int64(1), int64(2), int64(3)}
+	}}
+	r := newTestDB(conn).Model("role").Order("id", "ASC").PluckColumn(context.Background(), "id")
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	if query, _ := conn.lastQuery(); query != "SELECT id FROM role ORDER BY id ASC" {
+		t.Errorf("query = %q", query)
+	}
+	if want := []interface{}{int64(1), int64(2), int64(3)}; !reflect.DeepEqual(r.data, want) {
+		t.Errorf("PluckColumn = %v, want %v", r.data, want)
+	}
+}
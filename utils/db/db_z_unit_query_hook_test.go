@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// hookCall 记录一次查询钩子调用
+type hookCall struct {
+	query    string
+	args     []interface{}
+	duration time.Duration
+	err      error
+}
+
+func recordHook(calls *[]hookCall) QueryHook {
+	return func(ctx context.Context, query string, args []interface{}, duration time.Duration, err error) {
+		*calls = append(*calls, hookCall{query: query, args: args, duration: duration, err: err})
+	}
+}
+
+func TestDBManager_QueryHook(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		calls []hookCall
+		conn  = &fakeConn{affected: 1}
+		db    = newTestDB(conn).SetQueryHook(recordHook(&calls))
+	)
+
+	var rows []map[string]interface{}
+	_ = db.Query(ctx, &rows, "SELECT * FROM user WHERE id = ?", 1)
+	var row map[string]interface{}
+	_ = db.QueryRow(ctx, &row, "SELECT * FROM user WHERE id = ? LIMIT 1", 2)
+	_, _ = db.Exec(ctx, "UPDATE user SET name = ? WHERE id = ?", "john", 3)
+	db.Model("user").Where(map[string]interface{}{"status": 1}).Find(ctx, &rows)
+
+	want := []hookCall{
+		{query: "SELECT * FROM user WHERE id = ?", args: []interface{}{1}},
+		{query: "SELECT * FROM user WHERE id = ? LIMIT 1", args: []interface{}{2}},
+		{query: "UPDATE user SET name = ? WHERE id = ?", args: []interface{}{"john", 3}},
+		{query: "SELECT * FROM user WHERE status = ?", args: []interface{}{1}},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("hook called %d times, want %d", len(calls), len(want))
+	}
+	for i, call := range calls {
+		if call.query != want[i].query || !reflect.DeepEqual(call.args, want[i].args) {
+			t.Errorf("call %d = %q %v, want %q %v", i, call.query, call.args, want[i].query, want[i].args)
+		}
+		if call.duration < 0 {
+			t.Errorf("call %d duration = %v, want >= 0", i, call.duration)
+		}
+		if call.err != nil {
+			t.Errorf("call %d err = %v", i, call.err)
+		}
+	}
+}
+
+func TestDBManager_QueryHook_Error(t *testing.T) {
+	var (
+		calls   []hookCall
+		connErr = errors.New("connection refused")
+		db      = newTestDB(&fakeConn{err: connErr}).SetQueryHook(recordHook(&calls))
+	)
+	if _, err := db.Exec(context.Background(), "DELETE FROM user"); err != connErr {
+		t.Errorf("Exec err = %v, want %v", err, connErr)
+	}
+	if len(calls) != 1 || calls[0].err != connErr {
+		t.Errorf("hook calls = %+v, want one call with the error", calls)
+	}
+
+	db.SetQueryHook(nil)
+	_, _ = db.Exec(context.Background(), "DELETE FROM user")
+	if len(calls) != 1 {
+		t.Error("hook should not be called after it is removed")
+	}
+}
+
+func TestDBManager_QueryHook_Transaction(t *testing.T) {
+	var (
+		calls   []hookCall
+		session = &fakeConn{}
+		db      = newTestDB(&fakeConn{session: session}).SetQueryHook(recordHook(&calls))
+	)
+	err := db.Trans(context.Background(), func(ctx context.Context, s sqlx.Session) error {
+		return db.ModelTx(s, "user").Insert(ctx, map[string]interface{}{"name": "john"}).GetError()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(session.queries) != 1 || len(calls) != 1 || calls[0].query != session.queries[0] {
+		t.Errorf("hook calls = %+v, session queries = %v", calls, session.queries)
+	}
+}
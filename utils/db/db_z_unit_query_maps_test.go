@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// mapsDriver 返回固定混合类型结果集的 database/sql 测试驱动
+type mapsDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *mapsDriver) Open(name string) (driver.Conn, error) {
+	return &mapsDriverConn{driver: d}, nil
+}
+
+type mapsDriverConn struct {
+	driver *mapsDriver
+}
+
+func (c *mapsDriverConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (c *mapsDriverConn) Close() error { return nil }
+
+func (c *mapsDriverConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+func (c *mapsDriverConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &mapsDriverRows{columns: c.driver.columns, rows: c.driver.rows}, nil
+}
+
+type mapsDriverRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *mapsDriverRows) Columns() []string { return r.columns }
+
+func (r *mapsDriverRows) Close() error { return nil }
+
+func (r *mapsDriverRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+func newMapsDB(t *testing.T, d *mapsDriver) *DBManager {
+	t.Helper()
+	name := "db_maps_" + t.Name()
+	sql.Register(name, d)
+	rawDB, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	return newTestDB(&fakeConn{rawDB: rawDB})
+}
+
+func TestQueryMaps(t *testing.T) {
+	db := newMapsDB(t, &mapsDriver{
+		columns: []string{"id", "name", "score", "note"},
+		rows: [][]driver.Value{
+			{int64(1), []byte("alice"), 9.5, nil},
+			{int64(2), []byte("bob"), nil, []byte("vip")},
+		},
+	})
+	var hooked string
+	db.SetQueryHook(func(ctx context.Context, query string, args []interface{}, duration time.Duration, err error) {
+		hooked = query
+	})
+
+	rows, err := db.QueryMaps(context.Background(), "SELECT * FROM user WHERE id > ?", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{
+		{"id": int64(1), "name": "alice", "score": 9.5, "note": nil},
+		{"id": int64(2), "name": "bob", "score": nil, "note": "vip"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("QueryMaps = %#v, want %#v", rows, want)
+	}
+	if _, ok := rows[0]["note"]; !ok {
+		t.Error("NULL column should be present with a nil value")
+	}
+	if hooked != "SELECT * FROM user WHERE id > ?" {
+		t.Errorf("query hook got %q", hooked)
+	}
+
+	row, err := db.QueryMap(context.Background(), "SELECT * FROM user LIMIT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(row, want[0]) {
+		t.Errorf("QueryMap = %#v, want %#v", row, want[0])
+	}
+}
+
+func TestQueryMapNotFound(t *testing.T) {
+	db := newMapsDB(t, &mapsDriver{columns: []string{"id"}})
+
+	rows, err := db.QueryMaps(context.Background(), "SELECT id FROM user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows == nil || len(rows) != 0 {
+		t.Errorf("QueryMaps = %#v, want an empty slice", rows)
+	}
+	if _, err = db.QueryMap(context.Background(), "SELECT id FROM user"); err != sqlx.ErrNotFound {
+		t.Errorf("QueryMap err = %v, want sqlx.ErrNotFound", err)
+	}
+}
+
+func TestQueryMapsRawDBError(t *testing.T) {
+	db := newTestDB(&fakeConn{})
+	if _, err := db.QueryMaps(context.Background(), "SELECT 1"); err != sql.ErrConnDone {
+		t.Errorf("QueryMaps err = %v, want sql.ErrConnDone", err)
+	}
+}
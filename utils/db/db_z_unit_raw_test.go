@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRaw(t *testing.T) {
+	var (
+		conn = &fakeConn{scan: func(v any) {
+			*(v.(*[]map[string]interface{})) = []map[string]interface{}{{"id": 1}}
+		}}
+		db   = newTestDB(conn)
+		rows []map[string]interface{}
+	)
+	db.SetTablePrefix("sys_")
+	r := db.Raw(context.Background(), &rows, "SELECT * FROM {prefix}user u JOIN {prefix}role r ON u.role_id = r.id WHERE u.id > ? AND r.name = ?", 1, "admin")
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	want := "SELECT * FROM sys_user u JOIN sys_role r ON u.role_id = r.id WHERE u.id > ? AND r.name = ?"
+	query, args := conn.lastQuery()
+	if query != want || r.GetSQL() != want {
+		t.Errorf("query = %q, GetSQL = %q, want %q", query, r.GetSQL(), want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, "admin"}) || !reflect.DeepEqual(r.GetArgs(), []interface{}{1, "admin"}) {
+		t.Errorf("args = %v, GetArgs = %v, want [1 admin]", args, r.GetArgs())
+	}
+	if !reflect.DeepEqual(rows, []map[string]interface{}{{"id": 1}}) {
+		t.Errorf("rows = %v", rows)
+	}
+
+	conn.err = errors.New("query failed")
+	if r = db.Raw(context.Background(), &rows, "SELECT 1"); r.GetError() != conn.err {
+		t.Errorf("Raw err = %v, want %v", r.GetError(), conn.err)
+	}
+}
+
+func TestRawExec(t *testing.T) {
+	var (
+		conn = &fakeConn{affected: 2}
+		db   = newTestDB(conn)
+	)
+	db.SetTablePrefix("sys_")
+	result, err := db.RawExec(context.Background(), "UPDATE {prefix}user SET status = ? WHERE id IN (?, ?)", 0, 1, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if affected, _ := result.RowsAffected(); affected != 2 {
+		t.Errorf("RowsAffected = %d, want 2", affected)
+	}
+	query, args := conn.lastQuery()
+	if want := "UPDATE sys_user SET status = ? WHERE id IN (?, ?)"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{0, 1, "2"}) {
+		t.Errorf("args = %v, want [0 1 2]", args)
+	}
+
+	// 未设置表前缀时 {prefix} 被替换为空
+	db.SetTablePrefix("")
+	if _, err = db.RawExec(context.Background(), "DELETE FROM {prefix}user"); err != nil {
+		t.Fatal(err)
+	}
+	if query, _ = conn.lastQuery(); query != "DELETE FROM user" {
+		t.Errorf("query = %q, want %q", query, "DELETE FROM user")
+	}
+}
+
+func TestRaw_SQLFetch(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn).SetSQLFetch(true)
+	)
+	db.SetTablePrefix("sys_")
+	r := db.Raw(context.Background(), &[]map[string]interface{}{}, "SELECT * FROM {prefix}user WHERE id = ?", 1)
+	if r.GetError() != nil || r.GetSQL() != "SELECT * FROM sys_user WHERE id = ?" {
+		t.Errorf("GetSQL = %q, err = %v", r.GetSQL(), r.GetError())
+	}
+	result, err := db.RawExec(context.Background(), "DELETE FROM {prefix}user")
+	if result != nil || err != nil {
+		t.Errorf("RawExec = %v, %v, want nil, nil", result, err)
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("dry-run queries were executed: %v", conn.queries)
+	}
+}
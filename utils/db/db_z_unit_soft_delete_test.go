@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_SoftDelete(t *testing.T) {
+	tests := []struct {
+		name  string
+		model func(db *DBManager) *Model
+		sql   string
+		args  []interface{}
+	}{
+		{
+			name: "default filter",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").SoftDelete("deleted_at")
+			},
+			sql: "SELECT * FROM user WHERE deleted_at IS NULL",
+		},
+		{
+			name: "with trashed",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").SoftDelete("deleted_at").WithTrashed()
+			},
+			sql: "SELECT * FROM user",
+		},
+		{
+			name: "only trashed",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").SoftDelete("deleted_at").OnlyTrashed()
+			},
+			sql: "SELECT * FROM user WHERE deleted_at IS NOT NULL",
+		},
+		{
+			name: "user conditions are wrapped",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").SoftDelete("deleted_at").
+					Where(map[string]interface{}{"status": 1}).WhereIn("role", []interface{}{2})
+			},
+			sql:  "SELECT * FROM user WHERE (status = ? AND role IN (?)) AND deleted_at IS NULL",
+			args: []interface{}{1, 2},
+		},
+		{
+			name: "qualified by alias",
+			model: func(db *DBManager) *Model {
+				return db.Model("user").Alias("u").SoftDelete("deleted_at")
+			},
+			sql: "SELECT * FROM user AS u WHERE u.deleted_at IS NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{}
+			r := tt.model(newTestDB(conn)).Find(context.Background(), &[]map[string]interface{}{})
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			query, args := conn.lastQuery()
+			if query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+			if len(args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(args, tt.args) {
+					t.Errorf("args = %v, want %v", args, tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestModel_SoftDelete_Update(t *testing.T) {
+	conn := &fakeConn{affected: 1}
+	r := newTestDB(conn).Model("user").SoftDelete("deleted_at").
+		Where(map[string]interface{}{"id": 1}).
+		Update(context.Background(), map[string]interface{}{"name": "john"})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	if query, _ := conn.lastQuery(); query != "UPDATE user SET name = ? WHERE (id = ?) AND deleted_at IS NULL" {
+		t.Errorf("query = %q", query)
+	}
+}
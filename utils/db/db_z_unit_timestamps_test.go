@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"regexp"
+	"testing"
+)
+
+var timestampRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}$`)
+
+func TestModel_WithTimestamps_Insert(t *testing.T) {
+	conn := &fakeConn{insertID: 7}
+	r := newTestDB(conn).Model("user").WithTimestamps("created_at", "updated_at").
+		Insert(context.Background(), map[string]interface{}{"name": "john"})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	if id, _ := r.data.(int64); id != 7 {
+		t.Errorf("insert id = %v, want 7", r.data)
+	}
+	query, args := conn.lastQuery()
+	if want := "INSERT INTO user (created_at, name, updated_at) VALUES (?, ?, ?)"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if len(args) != 3 || args[1] != "john" {
+		t.Fatalf("args = %v", args)
+	}
+	for _, i := range []int{0, 2} {
+		if s, _ := args[i].(string); !timestampRegex.MatchString(s) {
+			t.Errorf("args[%d] = %v, want a Y-m-d H:i:s timestamp", i, args[i])
+		}
+	}
+}
+
+func TestModel_WithTimestamps_Update(t *testing.T) {
+	conn := &fakeConn{affected: 1}
+	r := newTestDB(conn).Model("user").WithTimestamps("created_at", "updated_at").
+		Where(map[string]interface{}{"id": 1}).
+		Update(context.Background(), map[string]interface{}{"name": "john"})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	if want := "UPDATE user SET name = ?, updated_at = ? WHERE id = ?"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if len(args) != 3 || args[0] != "john" || args[2] != 1 {
+		t.Fatalf("args = %v", args)
+	}
+	if s, _ := args[1].(string); !timestampRegex.MatchString(s) {
+		t.Errorf("updated_at = %v, want a Y-m-d H:i:s timestamp", args[1])
+	}
+}
+
+func TestModel_WithTimestamps_Options(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		conn = &fakeConn{}
+		db   = newTestDB(conn).SetTimeFormat("Y-m-d")
+		data = map[string]interface{}{"name": "john", "created_at": "2020-01-01"}
+	)
+
+	// An explicit value is kept, the configured format is used, and the source map is untouched.
+	db.Model("user").WithTimestamps("created_at", "updated_at").Insert(ctx, data)
+	query, args := conn.lastQuery()
+	if want := "INSERT INTO user (created_at, name, updated_at) VALUES (?, ?, ?)"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if args[0] != "2020-01-01" {
+		t.Errorf("created_at = %v, want the explicit value", args[0])
+	}
+	if s, _ := args[2].(string); !regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`).MatchString(s) {
+		t.Errorf("updated_at = %v, want a Y-m-d date", args[2])
+	}
+	if len(data) != 2 {
+		t.Errorf("source data was modified: %v", data)
+	}
+
+	// WithoutTimestamps disables injection for the query.
+	db.Model("user").WithTimestamps("created_at", "updated_at").WithoutTimestamps().
+		Insert(ctx, map[string]interface{}{"name": "john"})
+	if query, _ = conn.lastQuery(); query != "INSERT INTO user (name) VALUES (?)" {
+		t.Errorf("query = %q, want no timestamp columns", query)
+	}
+
+	// An empty field name is skipped.
+	db.Model("user").WithTimestamps("", "updated_at").Insert(ctx, map[string]interface{}{"name": "john"})
+	if query, _ = conn.lastQuery(); query != "INSERT INTO user (name, updated_at) VALUES (?, ?)" {
+		t.Errorf("query = %q, want only updated_at", query)
+	}
+}
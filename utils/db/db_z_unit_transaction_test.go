@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// txConn 模拟事务可见性的测试连接：事务内的插入在事务中可见，提交后写入连接，回滚时丢弃
+type txConn struct {
+	*fakeConn
+	rows int64 // 已提交的行数
+}
+
+func newTxConn() *txConn {
+	c := &txConn{fakeConn: &fakeConn{}}
+	c.scan = func(v any) {
+		*(v.(*int64)) = c.rows
+	}
+	return c
+}
+
+func (c *txConn) TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error {
+	var (
+		pending int64
+		tx      = &fakeConn{insertID: 1}
+	)
+	tx.scan = func(v any) {
+		// 事务内的查询可以看到未提交的插入
+		*(v.(*int64)) = c.rows + pending
+	}
+	session := &txSession{fakeConn: tx, inserted: &pending}
+	if err := fn(ctx, session); err != nil {
+		return err
+	}
+	c.rows += pending
+	return nil
+}
+
+// txSession 统计事务内插入行数的会话
+type txSession struct {
+	*fakeConn
+	inserted *int64
+}
+
+func (s *txSession) ExecCtx(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if strings.HasPrefix(query, "INSERT") {
+		*s.inserted++
+	}
+	return s.fakeConn.ExecCtx(ctx, query, args...)
+}
+
+func TestModelTx(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		conn = newTxConn()
+		db   = newTestDB(conn.fakeConn)
+	)
+	db.conn = conn
+	err := db.Trans(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if r := db.ModelTx(session, "user").Insert(ctx, map[string]interface{}{"name": "john"}); r.GetError() != nil {
+			return r.GetError()
+		}
+		r := db.ModelTx(session, "user").Count(ctx)
+		if r.GetError() != nil {
+			return r.GetError()
+		}
+		if count := r.data.(int64); count != 1 {
+			t.Errorf("count in transaction = %d, want 1", count)
+		}
+		// 事务外的查询看不到未提交的插入
+		if count := db.Model("user").Count(ctx).data.(int64); count != 0 {
+			t.Errorf("count outside transaction = %d, want 0", count)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := db.Model("user").Count(ctx).data.(int64); count != 1 {
+		t.Errorf("count after commit = %d, want 1", count)
+	}
+	// 事务内的语句不应通过默认连接执行
+	for _, query := range conn.queries {
+		if strings.HasPrefix(query, "INSERT") {
+			t.Errorf("insert executed outside the transaction: %q", query)
+		}
+	}
+}
+
+func TestModelTx_Rollback(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		conn    = newTxConn()
+		db      = newTestDB(conn.fakeConn)
+		errStop = errors.New("stop")
+	)
+	db.conn = conn
+	err := db.Trans(ctx, func(ctx context.Context, session sqlx.Session) error {
+		m := db.Model("user").WithSession(session)
+		if r := m.Insert(ctx, map[string]interface{}{"name": "john"}); r.GetError() != nil {
+			return r.GetError()
+		}
+		if count := db.ModelTx(session, "user").Count(ctx).data.(int64); count != 1 {
+			t.Errorf("count in transaction = %d, want 1", count)
+		}
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("Trans err = %v, want %v", err, errStop)
+	}
+	if count := db.Model("user").Count(ctx).data.(int64); count != 0 {
+		t.Errorf("count after rollback = %d, want 0", count)
+	}
+}
+
+func TestModel_WithSessionNil(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	db.Model("user").WithSession(nil).Find(context.Background(), &[]map[string]interface{}{})
+	if query, _ := conn.lastQuery(); query != "SELECT * FROM user" {
+		t.Errorf("query = %q, want it executed on the default connection", query)
+	}
+}
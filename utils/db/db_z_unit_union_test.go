@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_Union(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	r := db.Model("user").Fields("id", "name").Where(map[string]interface{}{"status": 1}).
+		Union(db.Model("admin").Fields("id", "name").Where(map[string]interface{}{"role": 2})).
+		UnionAll(db.Model("guest").Fields("id", "name").WhereIn("id", []interface{}{3, 4})).
+		Order("id", "DESC").Limit(10).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	want := "(SELECT id, name FROM user WHERE status = ?)" +
+		" UNION (SELECT id, name FROM admin WHERE role = ?)" +
+		" UNION ALL (SELECT id, name FROM guest WHERE id IN (?,?))" +
+		" ORDER BY id DESC LIMIT 10"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, 2, 3, 4}) {
+		t.Errorf("args = %v, want [1 2 3 4]", args)
+	}
+}
+
+func TestModel_Union_Errors(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	r := db.Model("user").Union(newTestDB(conn).Model("admin")).Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() == nil {
+		t.Error("union of models from different managers should fail")
+	}
+
+	r = db.Model("user").Union(db.Model("admin").SafeIdentifiers(true).Order("id;", "ASC")).
+		Find(context.Background(), &[]map[string]interface{}{})
+	if r.GetError() == nil {
+		t.Error("an error in the united model should be propagated")
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("failed queries were executed: %v", conn.queries)
+	}
+
+	r = db.Model("user").Union(nil).Find(context.Background(), &[]map[string]interface{}{})
+	if query, _ := conn.lastQuery(); r.GetError() != nil || query != "SELECT * FROM user" {
+		t.Errorf("Union(nil) query = %q, err = %v", query, r.GetError())
+	}
+}
+
+func TestModel_Union_Aggregate(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		union = func(db *DBManager) *Model {
+			return db.Model("user").Fields("id", "amount").Where(map[string]interface{}{"status": 1}).
+				UnionAll(db.Model("admin").Fields("id", "amount").Where(map[string]interface{}{"role": 2}))
+		}
+		inner = "(SELECT id, amount FROM user WHERE status = ?) UNION ALL (SELECT id, amount FROM admin WHERE role = ?)"
+	)
+	tests := []struct {
+		name string
+		run  func(m *Model) *QueryResult
+		sql  string
+	}{
+		{"Count", func(m *Model) *QueryResult { return m.Count(ctx) }, "SELECT COUNT(*) FROM (" + inner + ") AS t"},
+		{"CountDistinct", func(m *Model) *QueryResult { return m.CountDistinct(ctx, "id") }, "SELECT COUNT(DISTINCT id) FROM (" + inner + ") AS t"},
+		{"Exists", func(m *Model) *QueryResult { return m.Exists(ctx) }, "SELECT COUNT(*) FROM (" + inner + ") AS t"},
+		{"Sum", func(m *Model) *QueryResult { return m.Sum(ctx, "amount") }, "SELECT SUM(amount) FROM (" + inner + ") AS t"},
+		{"MaxString", func(m *Model) *QueryResult { return m.MaxString(ctx, "id") }, "SELECT MAX(id) FROM (" + inner + ") AS t"},
+		{"Value", func(m *Model) *QueryResult { return m.Value(ctx, "amount") }, "SELECT amount FROM (" + inner + " LIMIT 1) AS t"},
+		{"Column", func(m *Model) *QueryResult { return m.Column(ctx, "id") }, "SELECT id FROM (" + inner + ") AS t"},
+		{"Pluck", func(m *Model) *QueryResult { return m.Pluck(ctx, "id", "amount") }, "SELECT id AS pluck_key, amount AS pluck_value FROM (" + inner + ") AS t"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{}
+			r := tt.run(union(newTestDB(conn)))
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			query, args := conn.lastQuery()
+			if query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+			if !reflect.DeepEqual(args, []interface{}{1, 2}) {
+				t.Errorf("args = %v, want [1 2]", args)
+			}
+		})
+	}
+}
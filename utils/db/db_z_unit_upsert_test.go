@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestModel_Upsert(t *testing.T) {
+	conn := &fakeConn{affected: 1}
+	r := newTestDB(conn).Model("user").Upsert(context.Background(), map[string]interface{}{
+		"id":   1,
+		"name": "john",
+		"age":  18,
+	})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	want := "INSERT INTO user (age, id, name) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE age = VALUES(age), name = VALUES(name)"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{18, 1, "john"}) {
+		t.Errorf("args = %v", args)
+	}
+}
+
+func TestModel_UpsertBatch(t *testing.T) {
+	conn := &fakeConn{affected: 2}
+	r := newTestDB(conn).Model("dict").UpsertKeys("code").UpsertBatch(context.Background(), []map[string]interface{}{
+		{"code": "a", "label": "A"},
+		{"code": "b", "label": "B"},
+	})
+	if r.GetError() != nil {
+		t.Fatal(r.GetError())
+	}
+	query, args := conn.lastQuery()
+	want := "INSERT INTO dict (code, label) VALUES (?, ?), (?, ?) ON DUPLICATE KEY UPDATE label = VALUES(label)"
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"a", "A", "b", "B"}) {
+		t.Errorf("args = %v", args)
+	}
+}
+
+func TestModel_Upsert_UpdateColumns(t *testing.T) {
+	conn := &fakeConn{}
+	newTestDB(conn).Model("user").Upsert(context.Background(), map[string]interface{}{
+		"id":   1,
+		"name": "john",
+		"age":  18,
+	}, "name")
+	query, _ := conn.lastQuery()
+	if !strings.HasSuffix(query, "ON DUPLICATE KEY UPDATE name = VALUES(name)") {
+		t.Errorf("query = %q", query)
+	}
+}
+
+func TestModel_Upsert_Timestamps(t *testing.T) {
+	conn := &fakeConn{}
+	newTestDB(conn).Model("user").WithTimestamps("created_at", "updated_at").Upsert(context.Background(), map[string]interface{}{
+		"id":   1,
+		"name": "john",
+	})
+	query, _ := conn.lastQuery()
+	if !strings.HasSuffix(query, "ON DUPLICATE KEY UPDATE name = VALUES(name), updated_at = VALUES(updated_at)") {
+		t.Errorf("query = %q", query)
+	}
+}
+
+func TestModel_Upsert_Errors(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		conn  = &fakeConn{}
+		model = newTestDB(conn).Model("user")
+	)
+	if r := model.Upsert(ctx, map[string]interface{}{"id": 1}); r.GetError() == nil {
+		t.Error("expected error for upsert without non-key columns")
+	}
+	if r := model.UpsertBatch(ctx, []map[string]interface{}{{"id": 1, "a": 1}, {"id": 2, "b": 2}}); r.GetError() == nil {
+		t.Error("expected error for rows with different columns")
+	}
+	if r := model.UpsertBatch(ctx, nil); r.GetError() == nil {
+		t.Error("expected error for empty rows")
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("unexpected queries: %v", conn.queries)
+	}
+}
+
+func TestModel_Upsert_SQLFetch(t *testing.T) {
+	conn := &fakeConn{}
+	r := newTestDB(conn).Model("user").SQLFetch(true).Upsert(context.Background(), map[string]interface{}{
+		"id":   1,
+		"name": "john",
+	})
+	if r.GetError() != nil || !strings.HasPrefix(r.GetSQL(), "INSERT INTO user") {
+		t.Errorf("GetSQL = %q, err = %v", r.GetSQL(), r.GetError())
+	}
+	if len(conn.queries) != 0 {
+		t.Errorf("SQLFetch should not execute, got %v", conn.queries)
+	}
+}
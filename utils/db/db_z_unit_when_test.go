@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestModel_When(t *testing.T) {
+	var (
+		conn   = &fakeConn{}
+		db     = newTestDB(conn)
+		filter = func(name string, roles []interface{}) {
+			db.Model("user").Where(map[string]interface{}{"status": 1}).
+				When(name != "", func(m *Model) {
+					m.Where(map[string]interface{}{"name": name})
+				}).
+				When(len(roles) > 0, func(m *Model) {
+					m.WhereIn("role", roles)
+				}).
+				Find(context.Background(), &[]map[string]interface{}{})
+		}
+	)
+	tests := []struct {
+		name  string
+		roles []interface{}
+		query string
+		args  []interface{}
+	}{
+		{"john", []interface{}{1, 2}, "SELECT * FROM user WHERE status = ? AND name = ? AND role IN (?,?)", []interface{}{1, "john", 1, 2}},
+		{"john", nil, "SELECT * FROM user WHERE status = ? AND name = ?", []interface{}{1, "john"}},
+		{"", nil, "SELECT * FROM user WHERE status = ?", []interface{}{1}},
+	}
+	for _, tt := range tests {
+		filter(tt.name, tt.roles)
+		query, args := conn.lastQuery()
+		if query != tt.query {
+			t.Errorf("query = %q, want %q", query, tt.query)
+		}
+		if !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("args = %v, want %v", args, tt.args)
+		}
+	}
+
+	// 条件为 true 但回调为空时不应 panic
+	m := db.Model("user")
+	if m.When(true, nil) != m {
+		t.Error("When should return the same model")
+	}
+}
+
+func TestModel_WhenElse(t *testing.T) {
+	var (
+		conn = &fakeConn{}
+		db   = newTestDB(conn)
+	)
+	for _, deleted := range []bool{true, false} {
+		db.Model("user").
+			WhenElse(deleted, func(m *Model) {
+				m.WhereNotNull("deleted_at")
+			}, func(m *Model) {
+				m.WhereNull("deleted_at")
+			}).
+			Find(context.Background(), &[]map[string]interface{}{})
+	}
+	if len(conn.queries) != 2 {
+		t.Fatalf("queries = %v", conn.queries)
+	}
+	if want := "SELECT * FROM user WHERE deleted_at IS NOT NULL"; conn.queries[0] != want {
+		t.Errorf("then query = %q, want %q", conn.queries[0], want)
+	}
+	if want := "SELECT * FROM user WHERE deleted_at IS NULL"; conn.queries[1] != want {
+		t.Errorf("otherwise query = %q, want %q", conn.queries[1], want)
+	}
+}
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+func TestModel_Where_FirstCondition(t *testing.T) {
+	tests := []struct {
+		name  string
+		model func(m *Model) *Model
+		sql   string
+	}{
+		{"map", func(m *Model) *Model {
+			return m.Where(map[string]interface{}{"status": 1})
+		}, "SELECT * FROM user WHERE status = ?"},
+		{"map after WhereIn", func(m *Model) *Model {
+			return m.WhereIn("id", []interface{}{1, 2}).Where(map[string]interface{}{"status": 1})
+		}, "SELECT * FROM user WHERE id IN (?,?) AND status = ?"},
+		{"WhereIn after map", func(m *Model) *Model {
+			return m.Where(map[string]interface{}{"status": 1}).WhereIn("id", []interface{}{1, 2})
+		}, "SELECT * FROM user WHERE status = ? AND id IN (?,?)"},
+		{"map slice", func(m *Model) *Model {
+			return m.Where([]map[string]interface{}{{"status": 1}, {"role": 2}})
+		}, "SELECT * FROM user WHERE status = ? AND role = ?"},
+		// 第一个条件不输出连接符，软删除条件追加在用户条件之后
+		{"map with soft delete", func(m *Model) *Model {
+			return m.SoftDelete("deleted_at").Where(map[string]interface{}{"status": 1})
+		}, "SELECT * FROM user WHERE (status = ?) AND deleted_at IS NULL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{}
+			r := tt.model(newTestDB(conn).Model("user")).Find(context.Background(), &[]map[string]interface{}{})
+			if r.GetError() != nil {
+				t.Fatal(r.GetError())
+			}
+			if query, _ := conn.lastQuery(); query != tt.sql {
+				t.Errorf("sql = %q, want %q", query, tt.sql)
+			}
+		})
+	}
+}
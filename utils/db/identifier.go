@@ -0,0 +1,92 @@
+package db
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// identifierPartRegex 匹配标识符的一段，如 name 或反引号包裹的 `name`
+	identifierPartRegex = regexp.MustCompile("^(?:[A-Za-z_][A-Za-z0-9_]*|`[^`]+`)$")
+	// joinOnAndRegex 用于按 AND 拆分关联条件
+	joinOnAndRegex = regexp.MustCompile(`(?i)\s+AND\s+`)
+	// joinOnCondRegex 匹配单个关联条件，如 a.id = b.uid 或 a.type = ?
+	joinOnCondRegex = regexp.MustCompile(`^\s*([^\s=!<>]+)\s*(=|!=|<>|>=|<=|>|<)\s*([^\s=!<>]+)\s*$`)
+	// aggregateRegex 匹配对单个字段的聚合表达式，如 COUNT(*)、SUM(amount) 或 COUNT(DISTINCT uid)
+	aggregateRegex = regexp.MustCompile(`(?i)^\s*(COUNT|SUM|AVG|MIN|MAX)\(\s*(?:DISTINCT\s+)?([^()\s]+)\s*\)\s*$`)
+)
+
+// isSafeIdentifier 检查 name 是否为安全的标识符：
+// 字母或下划线开头、由字母数字下划线组成的名称，支持 table.col 形式和反引号包裹的形式，
+// 以及 * 和 table.* 形式的全部字段
+func isSafeIdentifier(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "*" {
+		return true
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if i > 0 && i == len(parts)-1 && part == "*" {
+			continue
+		}
+		if !identifierPartRegex.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSafeAggregate 检查 expr 是否为安全的标识符，或对安全标识符的 COUNT、SUM、AVG、MIN、MAX 聚合表达式
+func isSafeAggregate(expr string) bool {
+	if isSafeIdentifier(expr) {
+		return true
+	}
+	match := aggregateRegex.FindStringSubmatch(expr)
+	return match != nil && isSafeIdentifier(match[2])
+}
+
+// isSafeJoinOn 检查关联条件 on 是否只由 AND 连接的 标识符 操作符 标识符（或 ?）组成
+func isSafeJoinOn(on string) bool {
+	for _, cond := range joinOnAndRegex.Split(strings.TrimSpace(on), -1) {
+		match := joinOnCondRegex.FindStringSubmatch(cond)
+		if match == nil || !isSafeIdentifier(match[1]) {
+			return false
+		}
+		if match[3] != "?" && !isSafeIdentifier(match[3]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkIdentifiers 在开启标识符校验时检查 names，不合法时记录错误，在执行时返回
+func (qb *Model) checkIdentifiers(kind string, names ...string) {
+	if !qb.safeIdentifiers || qb.err != nil {
+		return
+	}
+	for _, name := range names {
+		if !isSafeIdentifier(name) {
+			qb.err = fmt.Errorf("unsafe %s identifier: %q", kind, name)
+			return
+		}
+	}
+}
+
+// checkHavingField 在开启标识符校验时检查HAVING条件的字段，允许对单个字段的聚合表达式
+func (qb *Model) checkHavingField(field string) {
+	if qb.safeIdentifiers && qb.err == nil && !isSafeAggregate(field) {
+		qb.err = fmt.Errorf("unsafe having identifier: %q", field)
+	}
+}
+
+// checkJoin 在开启标识符校验时检查关联表名、别名和关联条件
+func (qb *Model) checkJoin(table, alias, on string) {
+	qb.checkIdentifiers("join table", table)
+	if alias != "" {
+		qb.checkIdentifiers("join alias", alias)
+	}
+	if qb.safeIdentifiers && qb.err == nil && !isSafeJoinOn(on) {
+		qb.err = fmt.Errorf("unsafe join condition: %q", on)
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtime"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 // Model 链式查询构建器
@@ -25,8 +29,38 @@ type Model struct {
 	distinct bool
 	fields   []string
 	sqlFetch bool // 是否只输出SQL不执行查询
+
+	timestamps   bool   // 是否自动写入时间戳
+	createdField string // 创建时间字段
+	updatedField string // 更新时间字段
+
+	softDeleteField string      // 软删除字段
+	trashed         trashedMode // 软删除记录的查询方式
+
+	unions     []unionClause // 联合查询
+	indexHints []string      // 索引提示，如 FORCE INDEX (idx_name)
+	upsertKeys []string      // Upsert 的主键和唯一键字段，未指定更新字段时不更新这些字段，为空时为 id
+	err        error         // 构建查询过程中产生的错误，在执行时返回
+	session    sqlx.Session  // 事务会话，设置后查询和写入都在该会话中执行
+
+	safeIdentifiers bool // 是否校验字段、排序、分组等标识符，防止将用户输入拼接到SQL中
+}
+
+// unionClause 联合查询结构
+type unionClause struct {
+	all   bool // 是否为 UNION ALL
+	model *Model
 }
 
+// trashedMode 软删除记录的查询方式
+type trashedMode int
+
+const (
+	trashedExclude trashedMode = iota // 排除已软删除的记录（默认）
+	trashedInclude                    // 包含已软删除的记录
+	trashedOnly                       // 只查询已软删除的记录
+)
+
 // joinClause 关联查询结构
 type joinClause struct {
 	joinType string // LEFT, RIGHT, INNER
@@ -101,6 +135,126 @@ func (qb *Model) SQLFetch(fetch bool) *Model {
 	return qb
 }
 
+// SafeIdentifiers 设置是否开启标识符校验，开启后 Fields、Order、Group、关联查询、索引提示，
+// 以及插入、更新的数据字段和时间戳、软删除字段中不合法的字段名、表名和关联条件会使查询返回错误，需要在调用这些方法之前开启
+func (qb *Model) SafeIdentifiers(enable bool) *Model {
+	qb.safeIdentifiers = enable
+	return qb
+}
+
+// WithSession 设置执行查询使用的会话（如 Trans 回调中的事务会话），传入nil表示使用默认连接
+func (qb *Model) WithSession(session sqlx.Session) *Model {
+	qb.session = session
+	return qb
+}
+
+// conn 返回执行查询使用的会话，未设置事务会话时使用默认连接
+func (qb *Model) conn() sqlx.Session {
+	if qb.session != nil {
+		return qb.session
+	}
+	return qb.db.conn
+}
+
+// WithTimestamps 开启自动写入时间戳
+// 插入时自动写入 createdField 和 updatedField，更新时自动写入 updatedField，
+// 字段为空字符串时不写入，已在数据中指定的字段不会被覆盖
+func (qb *Model) WithTimestamps(createdField, updatedField string) *Model {
+	for _, field := range []string{createdField, updatedField} {
+		if field != "" {
+			qb.checkIdentifiers("timestamp", field)
+		}
+	}
+	qb.timestamps = true
+	qb.createdField = createdField
+	qb.updatedField = updatedField
+	return qb
+}
+
+// WithoutTimestamps 关闭自动写入时间戳
+func (qb *Model) WithoutTimestamps() *Model {
+	qb.timestamps = false
+	return qb
+}
+
+// SoftDelete 设置软删除字段
+// 设置后所有查询会自动追加 field IS NULL 条件，以排除已软删除的记录
+func (qb *Model) SoftDelete(field string) *Model {
+	qb.checkIdentifiers("soft delete", field)
+	qb.softDeleteField = field
+	return qb
+}
+
+// WithTrashed 查询时包含已软删除的记录
+func (qb *Model) WithTrashed() *Model {
+	qb.trashed = trashedInclude
+	return qb
+}
+
+// OnlyTrashed 只查询已软删除的记录
+func (qb *Model) OnlyTrashed() *Model {
+	qb.trashed = trashedOnly
+	return qb
+}
+
+// Union 使用 UNION 联合另一个查询
+// 外层设置的 ORDER BY、LIMIT、OFFSET 作用于整个联合结果
+// Count、Sum、Value 等统计和取值查询作用于整个联合结果，其字段需使用联合结果的列名
+func (qb *Model) Union(other *Model) *Model {
+	return qb.union(other, false)
+}
+
+// UnionAll 使用 UNION ALL 联合另一个查询
+// 外层设置的 ORDER BY、LIMIT、OFFSET 作用于整个联合结果
+func (qb *Model) UnionAll(other *Model) *Model {
+	return qb.union(other, true)
+}
+
+// union 添加联合查询，不同数据库管理器的查询不能联合
+func (qb *Model) union(other *Model, all bool) *Model {
+	if other == nil {
+		return qb
+	}
+	if other.db != qb.db {
+		qb.err = fmt.Errorf("cannot union models from different database managers")
+		return qb
+	}
+	if other.err != nil {
+		qb.err = other.err
+		return qb
+	}
+	qb.unions = append(qb.unions, unionClause{
+		all:   all,
+		model: other,
+	})
+	return qb
+}
+
+// UseIndex 设置USE INDEX索引提示
+func (qb *Model) UseIndex(index ...string) *Model {
+	return qb.indexHint("USE INDEX", index)
+}
+
+// ForceIndex 设置FORCE INDEX索引提示
+func (qb *Model) ForceIndex(index ...string) *Model {
+	return qb.indexHint("FORCE INDEX", index)
+}
+
+// IgnoreIndex 设置IGNORE INDEX索引提示
+func (qb *Model) IgnoreIndex(index ...string) *Model {
+	return qb.indexHint("IGNORE INDEX", index)
+}
+
+// indexHint 添加索引提示，多个索引以逗号分隔
+func (qb *Model) indexHint(hint string, index []string) *Model {
+	if len(index) == 0 {
+		return qb
+	}
+	qb.checkIdentifiers("index", index...)
+	qb.indexHints = append(qb.indexHints, fmt.Sprintf("%s (%s)", hint, strings.Join(index, ", ")))
+	return qb
+}
+
 // Alias 设置表别名
 func (qb *Model) Alias(alias string) *Model {
 	qb.alias = alias
@@ -125,6 +279,7 @@ func (qb *Model) Fields(fields ...string) *Model {
 		// 多个参数形式，直接使用
 		qb.fields = fields
 	}
+	qb.checkIdentifiers("field", qb.fields...)
 	return qb
 }
 
@@ -136,6 +291,7 @@ func (qb *Model) Distinct() *Model {
 
 // LeftJoin 左关联
 func (qb *Model) LeftJoin(table, alias, on string, args ...interface{}) *Model {
+	qb.checkJoin(table, alias, on)
 	qb.joins = append(qb.joins, joinClause{
 		joinType: "LEFT",
 		table:    qb.db.formatTableName(table), // 格式化关联表名
@@ -148,6 +304,7 @@ func (qb *Model) LeftJoin(table, alias, on string, args ...interface{}) *Model {
 
 // RightJoin 右关联
 func (qb *Model) RightJoin(table, alias, on string, args ...interface{}) *Model {
+	qb.checkJoin(table, alias, on)
 	qb.joins = append(qb.joins, joinClause{
 		joinType: "RIGHT",
 		table:    qb.db.formatTableName(table), // 格式化关联表名
@@ -160,6 +317,7 @@ func (qb *Model) RightJoin(table, alias, on string, args ...interface{}) *Model
 
 // Join 内关联
 func (qb *Model) Join(table, alias, on string, args ...interface{}) *Model {
+	qb.checkJoin(table, alias, on)
 	qb.joins = append(qb.joins, joinClause{
 		joinType: "INNER",
 		table:    qb.db.formatTableName(table), // 格式化关联表名
@@ -176,8 +334,13 @@ func (qb *Model) Where(conditions interface{}, args ...interface{}) *Model {
 	case map[string]interface{}:
 		// 处理map类型条件
 		for field, value := range cond {
+			qb.checkIdentifiers("where", field)
+			operator := "AND"
+			if len(qb.where) == 0 {
+				operator = "" // 第一个条件不加AND
+			}
 			qb.where = append(qb.where, whereClause{
-				operator: "AND",
+				operator: operator,
 				field:    field,
 				cond:     "= ?",
 				args:     []interface{}{value},
@@ -187,6 +350,7 @@ func (qb *Model) Where(conditions interface{}, args ...interface{}) *Model {
 		// 处理map切片类型条件
 		for i, condition := range cond {
 			for field, value := range condition {
+				qb.checkIdentifiers("where", field)
 				operator := "AND"
 				if i == 0 && len(qb.where) == 0 {
 					operator = "" // 第一个条件不加AND
@@ -217,6 +381,7 @@ func (qb *Model) Where(conditions interface{}, args ...interface{}) *Model {
 
 // WhereOr 设置OR条件
 func (qb *Model) WhereOr(field string, args ...interface{}) *Model {
+	qb.checkIdentifiers("where", field)
 	operator := "OR"
 	if len(qb.where) == 0 {
 		operator = ""
@@ -232,6 +397,7 @@ func (qb *Model) WhereOr(field string, args ...interface{}) *Model {
 
 // WhereIn 设置IN条件
 func (qb *Model) WhereIn(field string, values []interface{}) *Model {
+	qb.checkIdentifiers("where", field)
 	if len(values) == 0 {
 		return qb
 	}
@@ -257,6 +423,7 @@ func (qb *Model) WhereIn(field string, values []interface{}) *Model {
 
 // WhereNotIn 设置NOT IN条件
 func (qb *Model) WhereNotIn(field string, values []interface{}) *Model {
+	qb.checkIdentifiers("where", field)
 	if len(values) == 0 {
 		return qb
 	}
@@ -282,6 +449,7 @@ func (qb *Model) WhereNotIn(field string, values []interface{}) *Model {
 
 // WhereBetween 设置BETWEEN条件
 func (qb *Model) WhereBetween(field string, start, end interface{}) *Model {
+	qb.checkIdentifiers("where", field)
 	operator := "AND"
 	if len(qb.where) == 0 {
 		operator = ""
@@ -298,6 +466,7 @@ func (qb *Model) WhereBetween(field string, start, end interface{}) *Model {
 
 // WhereNull 设置IS NULL条件
 func (qb *Model) WhereNull(field string) *Model {
+	qb.checkIdentifiers("where", field)
 	operator := "AND"
 	if len(qb.where) == 0 {
 		operator = ""
@@ -314,6 +483,7 @@ func (qb *Model) WhereNull(field string) *Model {
 
 // WhereNotNull 设置IS NOT NULL条件
 func (qb *Model) WhereNotNull(field string) *Model {
+	qb.checkIdentifiers("where", field)
 	operator := "AND"
 	if len(qb.where) == 0 {
 		operator = ""
@@ -328,8 +498,60 @@ func (qb *Model) WhereNotNull(field string) *Model {
 	return qb
 }
 
+// WhereExists 设置EXISTS子查询条件，子查询的参数按条件顺序合并到查询参数中
+func (qb *Model) WhereExists(sub *Model) *Model {
+	return qb.whereExists("EXISTS", sub)
+}
+
+// WhereNotExists 设置NOT EXISTS子查询条件
+func (qb *Model) WhereNotExists(sub *Model) *Model {
+	return qb.whereExists("NOT EXISTS", sub)
+}
+
+// whereExists 添加EXISTS/NOT EXISTS子查询条件
+func (qb *Model) whereExists(keyword string, sub *Model) *Model {
+	if sub == nil {
+		return qb
+	}
+	if sub.err != nil {
+		qb.err = sub.err
+		return qb
+	}
+	query, args := sub.buildQuery()
+
+	operator := "AND"
+	if len(qb.where) == 0 {
+		operator = ""
+	}
+
+	qb.where = append(qb.where, whereClause{
+		operator: operator,
+		field:    keyword,
+		cond:     fmt.Sprintf("(%s)", query),
+		args:     args,
+	})
+	return qb
+}
+
+// When 当 condition 为 true 时调用 then 回调追加查询条件，便于按可选参数链式组装查询
+func (qb *Model) When(condition bool, then func(m *Model)) *Model {
+	if condition && then != nil {
+		then(qb)
+	}
+	return qb
+}
+
+// WhenElse 当 condition 为 true 时调用 then 回调，否则调用 otherwise 回调
+func (qb *Model) WhenElse(condition bool, then, otherwise func(m *Model)) *Model {
+	if condition {
+		return qb.When(true, then)
+	}
+	return qb.When(true, otherwise)
+}
+
 // GroupBy 设置分组
 func (qb *Model) Group(fields ...string) *Model {
+	qb.checkIdentifiers("group", fields...)
 	qb.groupBy = append(qb.groupBy, fields...)
 	return qb
 }
@@ -345,8 +567,47 @@ func (qb *Model) Having(condition string, args ...interface{}) *Model {
 	return qb
 }
 
+// HavingOp 设置参数化的HAVING条件，如 HavingOp("COUNT(*)", ">", 10) 生成 COUNT(*) > ?
+// 多个HAVING条件使用AND连接，op 仅支持 =、!=、<>、>、>=、<、<=
+func (qb *Model) HavingOp(field, op string, value interface{}) *Model {
+	op = strings.TrimSpace(op)
+	switch op {
+	case "=", "!=", "<>", ">", ">=", "<", "<=":
+	default:
+		if qb.err == nil {
+			qb.err = fmt.Errorf("unsupported having operator: %s", op)
+		}
+		return qb
+	}
+	qb.checkHavingField(field)
+	return qb.Having(fmt.Sprintf("%s %s ?", field, op), value)
+}
+
+// HavingEq 设置 field = value 的HAVING条件
+func (qb *Model) HavingEq(field string, value interface{}) *Model {
+	return qb.HavingOp(field, "=", value)
+}
+
+// HavingGt 设置 field > value 的HAVING条件
+func (qb *Model) HavingGt(field string, value interface{}) *Model {
+	return qb.HavingOp(field, ">", value)
+}
+
+// HavingLt 设置 field < value 的HAVING条件
+func (qb *Model) HavingLt(field string, value interface{}) *Model {
+	return qb.HavingOp(field, "<", value)
+}
+
 // Order 设置排序
 func (qb *Model) Order(field, direction string) *Model {
+	qb.checkIdentifiers("order", field)
+	if qb.safeIdentifiers && qb.err == nil {
+		switch strings.ToUpper(strings.TrimSpace(direction)) {
+		case "", "ASC", "DESC":
+		default:
+			qb.err = fmt.Errorf("unsafe order direction: %q", direction)
+		}
+	}
 	qb.orderBy = append(qb.orderBy, orderClause{
 		field: field,
 		dir:   strings.ToUpper(direction),
@@ -387,6 +648,39 @@ func (qb *Model) Page(page, pageSize int) *Model {
 	return qb
 }
 
+// AfterCursor 设置游标分页（keyset），查询 column 大于 lastValue 的下一页，按 column 升序取 pageSize 条
+// 可与已有条件组合使用，lastValue 为 nil 时表示查询第一页；column 应当唯一且有索引，避免遗漏或重复记录
+// 游标分页必须只按 column 排序，之前通过 Order 等设置的排序会被替换
+func (qb *Model) AfterCursor(column string, lastValue interface{}, pageSize int) *Model {
+	return qb.cursor(column, "> ?", "ASC", lastValue, pageSize)
+}
+
+// BeforeCursor 设置游标分页（keyset），查询 column 小于 lastValue 的上一页，按 column 降序取 pageSize 条
+// 注意：结果为降序排列，如需按升序展示需自行反转；column 应当唯一且有索引
+func (qb *Model) BeforeCursor(column string, lastValue interface{}, pageSize int) *Model {
+	return qb.cursor(column, "< ?", "DESC", lastValue, pageSize)
+}
+
+// cursor 添加游标条件、排序和条数限制，并清除偏移量
+// 已有排序会被替换为 column 排序，否则先生效的其他排序会导致按游标翻页时数据错乱
+func (qb *Model) cursor(column, cond, direction string, lastValue interface{}, pageSize int) *Model {
+	if lastValue != nil {
+		operator := "AND"
+		if len(qb.where) == 0 {
+			operator = ""
+		}
+		qb.where = append(qb.where, whereClause{
+			operator: operator,
+			field:    column,
+			cond:     cond,
+			args:     []interface{}{lastValue},
+		})
+	}
+	qb.offset = 0
+	qb.orderBy = make([]orderClause, 0, 1)
+	return qb.Order(column, direction).Limit(pageSize)
+}
+
 // ForUpdate 设置FOR UPDATE锁
 func (qb *Model) ForUpdate() *Model {
 	qb.lockMode = "FOR UPDATE"
@@ -401,6 +695,12 @@ func (qb *Model) LockInShareMode() *Model {
 
 // Find 执行查询
 func (qb *Model) Find(ctx context.Context, dest interface{}) *QueryResult {
+	if qb.err != nil {
+		return &QueryResult{
+			data: dest,
+			err:  qb.err,
+		}
+	}
 	query, args := qb.buildQuery()
 
 	// 如果设置了SQLFetch，只输出SQL不执行查询
@@ -414,7 +714,7 @@ func (qb *Model) Find(ctx context.Context, dest interface{}) *QueryResult {
 		}
 	}
 
-	err := qb.db.Query(ctx, dest, query, args...)
+	err := qb.db.query(ctx, qb.conn(), dest, query, args...)
 	return &QueryResult{
 		data:  dest,
 		err:   err,
@@ -425,6 +725,12 @@ func (qb *Model) Find(ctx context.Context, dest interface{}) *QueryResult {
 
 // FindOne 执行单条查询
 func (qb *Model) FindOne(ctx context.Context, dest interface{}) *QueryResult {
+	if qb.err != nil {
+		return &QueryResult{
+			data: dest,
+			err:  qb.err,
+		}
+	}
 	qb.Limit(1)
 	query, args := qb.buildQuery()
 
@@ -439,7 +745,7 @@ func (qb *Model) FindOne(ctx context.Context, dest interface{}) *QueryResult {
 		}
 	}
 
-	err := qb.db.QueryRow(ctx, dest, query, args...)
+	err := qb.db.queryRow(ctx, qb.conn(), dest, query, args...)
 	return &QueryResult{
 		data:  dest,
 		err:   err,
@@ -450,8 +756,25 @@ func (qb *Model) FindOne(ctx context.Context, dest interface{}) *QueryResult {
 
 // Count 统计数量
 func (qb *Model) Count(ctx context.Context) *QueryResult {
-	qb.fields = []string{"COUNT(*)"}
-	query, args := qb.buildQuery()
+	return qb.count(ctx, "COUNT(*)")
+}
+
+// CountDistinct 统计字段去重后的数量，生成 SELECT COUNT(DISTINCT field)，结果为 int64
+// 与 Group 同时使用时按分组分别统计，与 Count 相同只返回第一个分组内的去重数量
+func (qb *Model) CountDistinct(ctx context.Context, field string) *QueryResult {
+	qb.checkIdentifiers("count", field)
+	return qb.count(ctx, fmt.Sprintf("COUNT(DISTINCT %s)", field))
+}
+
+// count 使用统计表达式 expr 统计数量
+func (qb *Model) count(ctx context.Context, expr string) *QueryResult {
+	if qb.err != nil {
+		return &QueryResult{
+			data: int64(0),
+			err:  qb.err,
+		}
+	}
+	query, args := qb.buildFieldsQuery(expr)
 
 	// 如果设置了SQLFetch，只输出SQL不执行查询
 	if qb.sqlFetch {
@@ -465,7 +788,7 @@ func (qb *Model) Count(ctx context.Context) *QueryResult {
 	}
 
 	var count int64
-	err := qb.db.QueryRow(ctx, &count, query, args...)
+	err := qb.db.queryRow(ctx, qb.conn(), &count, query, args...)
 	return &QueryResult{
 		data:  count,
 		err:   err,
@@ -506,8 +829,53 @@ func (qb *Model) Exists(ctx context.Context) *QueryResult {
 
 // Sum 查询指定字段的合计数
 func (qb *Model) Sum(ctx context.Context, field string) *QueryResult {
-	qb.fields = []string{fmt.Sprintf("SUM(%s)", field)}
-	query, args := qb.buildQuery()
+	return qb.aggregateFloat(ctx, "SUM", field)
+}
+
+// Avg 查询指定字段的平均值，没有记录时返回0
+func (qb *Model) Avg(ctx context.Context, field string) *QueryResult {
+	return qb.aggregateFloat(ctx, "AVG", field)
+}
+
+// Min 查询指定数值字段的最小值，没有记录时返回0
+func (qb *Model) Min(ctx context.Context, field string) *QueryResult {
+	return qb.aggregateFloat(ctx, "MIN", field)
+}
+
+// Max 查询指定数值字段的最大值，没有记录时返回0
+func (qb *Model) Max(ctx context.Context, field string) *QueryResult {
+	return qb.aggregateFloat(ctx, "MAX", field)
+}
+
+// MinString 查询指定字段的最小值（适用于字符串、日期等非数值字段），没有记录时返回空字符串
+func (qb *Model) MinString(ctx context.Context, field string) *QueryResult {
+	qb.checkIdentifiers("min", field)
+	return qb.aggregateString(ctx, fmt.Sprintf("MIN(%s)", field))
+}
+
+// MaxString 查询指定字段的最大值（适用于字符串、日期等非数值字段），没有记录时返回空字符串
+func (qb *Model) MaxString(ctx context.Context, field string) *QueryResult {
+	qb.checkIdentifiers("max", field)
+	return qb.aggregateString(ctx, fmt.Sprintf("MAX(%s)", field))
+}
+
+// GroupConcat 使用分隔符拼接指定字段的值，没有记录时返回空字符串
+func (qb *Model) GroupConcat(ctx context.Context, field, separator string) *QueryResult {
+	qb.checkIdentifiers("group concat", field)
+	separator = strings.NewReplacer(`\`, `\\`, "'", "''").Replace(separator)
+	return qb.aggregateString(ctx, fmt.Sprintf("GROUP_CONCAT(%s SEPARATOR '%s')", field, separator))
+}
+
+// aggregateFloat 执行数值聚合查询，NULL结果返回0
+func (qb *Model) aggregateFloat(ctx context.Context, fn, field string) *QueryResult {
+	qb.checkIdentifiers(strings.ToLower(fn), field)
+	if qb.err != nil {
+		return &QueryResult{
+			data: float64(0),
+			err:  qb.err,
+		}
+	}
+	query, args := qb.buildFieldsQuery(fmt.Sprintf("%s(%s)", fn, field))
 
 	// 如果设置了SQLFetch，只输出SQL不执行查询
 	if qb.sqlFetch {
@@ -520,12 +888,49 @@ func (qb *Model) Sum(ctx context.Context, field string) *QueryResult {
 		}
 	}
 
-	var sum sql.NullFloat64
-	err := qb.db.QueryRow(ctx, &sum, query, args...)
+	var value sql.NullFloat64
+	err := qb.db.queryRow(ctx, qb.conn(), &value, query, args...)
 
 	var result float64
-	if err == nil && sum.Valid {
-		result = sum.Float64
+	if err == nil && value.Valid {
+		result = value.Float64
+	}
+
+	return &QueryResult{
+		data:  result,
+		err:   err,
+		query: query,
+		args:  args,
+	}
+}
+
+// aggregateString 执行字符串聚合查询，NULL结果返回空字符串
+func (qb *Model) aggregateString(ctx context.Context, expr string) *QueryResult {
+	if qb.err != nil {
+		return &QueryResult{
+			data: "",
+			err:  qb.err,
+		}
+	}
+	query, args := qb.buildFieldsQuery(expr)
+
+	// 如果设置了SQLFetch，只输出SQL不执行查询
+	if qb.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return &QueryResult{
+			data:  "",
+			err:   nil,
+			query: query,
+			args:  args,
+		}
+	}
+
+	var value sql.NullString
+	err := qb.db.queryRow(ctx, qb.conn(), &value, query, args...)
+
+	var result string
+	if err == nil && value.Valid {
+		result = value.String
 	}
 
 	return &QueryResult{
@@ -538,9 +943,15 @@ func (qb *Model) Sum(ctx context.Context, field string) *QueryResult {
 
 // Value 获取指定字段的值（单条记录）
 func (qb *Model) Value(ctx context.Context, field string) *QueryResult {
-	qb.fields = []string{field}
+	qb.checkIdentifiers("value", field)
+	if qb.err != nil {
+		return &QueryResult{
+			data: nil,
+			err:  qb.err,
+		}
+	}
 	qb.Limit(1)
-	query, args := qb.buildQuery()
+	query, args := qb.buildFieldsQuery(field)
 
 	// 如果设置了SQLFetch，只输出SQL不执行查询
 	if qb.sqlFetch {
@@ -554,7 +965,7 @@ func (qb *Model) Value(ctx context.Context, field string) *QueryResult {
 	}
 
 	var value interface{}
-	err := qb.db.QueryRow(ctx, &value, query, args...)
+	err := qb.db.queryRow(ctx, qb.conn(), &value, query, args...)
 	return &QueryResult{
 		data:  value,
 		err:   err,
@@ -565,8 +976,14 @@ func (qb *Model) Value(ctx context.Context, field string) *QueryResult {
 
 // Column 获取单一字段的所有值
 func (qb *Model) Column(ctx context.Context, field string) *QueryResult {
-	qb.fields = []string{field}
-	query, args := qb.buildQuery()
+	qb.checkIdentifiers("column", field)
+	if qb.err != nil {
+		return &QueryResult{
+			data: []interface{}{},
+			err:  qb.err,
+		}
+	}
+	query, args := qb.buildFieldsQuery(field)
 
 	// 如果设置了SQLFetch，只输出SQL不执行查询
 	if qb.sqlFetch {
@@ -580,7 +997,7 @@ func (qb *Model) Column(ctx context.Context, field string) *QueryResult {
 	}
 
 	var results []interface{}
-	err := qb.db.Query(ctx, &results, query, args...)
+	err := qb.db.query(ctx, qb.conn(), &results, query, args...)
 	return &QueryResult{
 		data:  results,
 		err:   err,
@@ -589,44 +1006,351 @@ func (qb *Model) Column(ctx context.Context, field string) *QueryResult {
 	}
 }
 
-// buildQuery 构建SQL查询
-func (qb *Model) buildQuery() (string, []interface{}) {
-	var sql strings.Builder
-	var args []interface{}
+// PluckColumn 获取指定字段的值（多条记录），与 Column 相同
+func (qb *Model) PluckColumn(ctx context.Context, field string) *QueryResult {
+	return qb.Column(ctx, field)
+}
 
-	// SELECT 子句
-	sql.WriteString("SELECT ")
-	if qb.distinct {
-		sql.WriteString("DISTINCT ")
+// pluckRow Pluck 查询的行结构
+type pluckRow struct {
+	Key   interface{} `db:"pluck_key"`
+	Value interface{} `db:"pluck_value"`
+}
+
+// Pluck 获取 keyField => valueField 的映射（多条记录），常用于构建下拉选项，重复的键保留最后一行的值
+func (qb *Model) Pluck(ctx context.Context, keyField, valueField string) *QueryResult {
+	qb.checkIdentifiers("pluck", keyField, valueField)
+	if qb.err != nil {
+		return &QueryResult{
+			data: map[interface{}]interface{}{},
+			err:  qb.err,
+		}
 	}
-	sql.WriteString(strings.Join(qb.fields, ", "))
+	query, args := qb.buildFieldsQuery(keyField+" AS pluck_key", valueField+" AS pluck_value")
 
-	// FROM 子句
-	sql.WriteString(" FROM ")
-	sql.WriteString(qb.table)
-	if qb.alias != "" {
-		sql.WriteString(" AS ")
-		sql.WriteString(qb.alias)
+	// 如果设置了SQLFetch，只输出SQL不执行查询
+	if qb.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return &QueryResult{
+			data:  map[interface{}]interface{}{},
+			err:   nil,
+			query: query,
+			args:  args,
+		}
 	}
 
-	// JOIN 子句
-	for _, join := range qb.joins {
-		sql.WriteString(" ")
-		sql.WriteString(join.joinType)
-		sql.WriteString(" JOIN ")
-		sql.WriteString(join.table)
-		if join.alias != "" {
-			sql.WriteString(" AS ")
-			sql.WriteString(join.alias)
+	var rows []pluckRow
+	err := qb.db.query(ctx, qb.conn(), &rows, query, args...)
+	result := make(map[interface{}]interface{}, len(rows))
+	for _, row := range rows {
+		result[pluckValue(row.Key)] = pluckValue(row.Value)
+	}
+	return &QueryResult{
+		data:  result,
+		err:   err,
+		query: query,
+		args:  args,
+	}
+}
+
+// pluckValue 将驱动返回的 []byte 转换为 string，使其可以作为 map 的键
+func pluckValue(v interface{}) interface{} {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
+
+// Insert 插入一条记录，返回结果为自增ID
+func (qb *Model) Insert(ctx context.Context, data map[string]interface{}) *QueryResult {
+	qb.checkIdentifiers("insert", sortedKeys(data)...)
+	if qb.err != nil {
+		return &QueryResult{
+			data: int64(0),
+			err:  qb.err,
 		}
-		sql.WriteString(" ON ")
-		sql.WriteString(join.on)
-		args = append(args, join.args...)
 	}
+	query, args := qb.buildInsert(qb.fillTimestamps(data, true))
 
-	// WHERE 子句
+	// 如果设置了SQLFetch，只输出SQL不执行查询
+	if qb.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return &QueryResult{
+			data:  int64(0),
+			err:   nil,
+			query: query,
+			args:  args,
+		}
+	}
+
+	var id int64
+	res, err := qb.db.exec(ctx, qb.conn(), query, args...)
+	if err == nil {
+		id, err = res.LastInsertId()
+	}
+	return &QueryResult{
+		data:  id,
+		err:   err,
+		query: query,
+		args:  args,
+	}
+}
+
+// Update 更新记录，返回结果为受影响的行数
+// 为避免误更新整张表，必须设置WHERE条件
+func (qb *Model) Update(ctx context.Context, data map[string]interface{}) *QueryResult {
+	qb.checkIdentifiers("update", sortedKeys(data)...)
+	if qb.err != nil {
+		return &QueryResult{
+			data: int64(0),
+			err:  qb.err,
+		}
+	}
+	query, args := qb.buildUpdate(qb.fillTimestamps(data, false))
+	if len(qb.where) == 0 {
+		return &QueryResult{
+			data:  int64(0),
+			err:   fmt.Errorf("update without where condition is not allowed"),
+			query: query,
+			args:  args,
+		}
+	}
+
+	// 如果设置了SQLFetch，只输出SQL不执行查询
+	if qb.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return &QueryResult{
+			data:  int64(0),
+			err:   nil,
+			query: query,
+			args:  args,
+		}
+	}
+
+	var affected int64
+	res, err := qb.db.exec(ctx, qb.conn(), query, args...)
+	if err == nil {
+		affected, err = res.RowsAffected()
+	}
+	return &QueryResult{
+		data:  affected,
+		err:   err,
+		query: query,
+		args:  args,
+	}
+}
+
+// UpsertKeys 设置表的主键和唯一键字段，默认为 id
+// Upsert 未指定 updateColumns 时不会更新这些字段，避免冲突发生在其他唯一键上时改写已有记录的主键
+func (qb *Model) UpsertKeys(columns ...string) *Model {
+	qb.checkIdentifiers("upsert key", columns...)
+	qb.upsertKeys = columns
+	return qb
+}
+
+// Upsert 插入一条记录，唯一键冲突时更新 updateColumns 指定的字段（MySQL ON DUPLICATE KEY UPDATE），返回结果为受影响的行数
+// updateColumns 为空时更新插入的所有非键字段，即排除 UpsertKeys 设置的键字段（默认为 id）和自动写入的创建时间字段，
+// 没有可更新的字段时返回错误
+func (qb *Model) Upsert(ctx context.Context, data map[string]interface{}, updateColumns ...string) *QueryResult {
+	return qb.UpsertBatch(ctx, []map[string]interface{}{data}, updateColumns...)
+}
+
+// UpsertBatch 批量插入记录，唯一键冲突时更新 updateColumns 指定的字段，返回结果为受影响的行数
+// 所有记录的字段必须一致，updateColumns 为空时的行为与 Upsert 相同
+func (qb *Model) UpsertBatch(ctx context.Context, rows []map[string]interface{}, updateColumns ...string) *QueryResult {
+	// 各行字段不一致时 buildUpsert 会返回错误，因此只需校验第一行的字段
+	if len(rows) > 0 {
+		qb.checkIdentifiers("upsert", sortedKeys(rows[0])...)
+	}
+	qb.checkIdentifiers("upsert update", updateColumns...)
+	if qb.err != nil {
+		return &QueryResult{
+			data: int64(0),
+			err:  qb.err,
+		}
+	}
+	filledRows := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		filledRows[i] = qb.fillTimestamps(row, true)
+	}
+	query, args, err := qb.buildUpsert(filledRows, updateColumns)
+	if err != nil {
+		return &QueryResult{
+			data:  int64(0),
+			err:   err,
+			query: query,
+			args:  args,
+		}
+	}
+
+	// 如果设置了SQLFetch，只输出SQL不执行查询
+	if qb.sqlFetch {
+		fmt.Printf("SQL: %s\nArgs: %v\n", query, args)
+		return &QueryResult{
+			data:  int64(0),
+			err:   nil,
+			query: query,
+			args:  args,
+		}
+	}
+
+	var affected int64
+	res, err := qb.db.exec(ctx, qb.conn(), query, args...)
+	if err == nil {
+		affected, err = res.RowsAffected()
+	}
+	return &QueryResult{
+		data:  affected,
+		err:   err,
+		query: query,
+		args:  args,
+	}
+}
+
+// buildUpsert 构建 INSERT ... ON DUPLICATE KEY UPDATE 语句
+func (qb *Model) buildUpsert(rows []map[string]interface{}, updateColumns []string) (string, []interface{}, error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return "", nil, fmt.Errorf("upsert without data is not allowed")
+	}
+	var (
+		keys         = sortedKeys(rows[0])
+		placeholders = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ") + ")"
+		values       = make([]string, len(rows))
+		args         = make([]interface{}, 0, len(keys)*len(rows))
+	)
+	for i, row := range rows {
+		if len(row) != len(keys) {
+			return "", nil, fmt.Errorf("upsert rows must have the same columns")
+		}
+		for _, k := range keys {
+			v, ok := row[k]
+			if !ok {
+				return "", nil, fmt.Errorf("upsert rows must have the same columns, column %s is missing", k)
+			}
+			args = append(args, v)
+		}
+		values[i] = placeholders
+	}
+	if len(updateColumns) == 0 {
+		keyColumns := qb.upsertKeys
+		if len(keyColumns) == 0 {
+			keyColumns = []string{"id"}
+		}
+		for _, k := range keys {
+			if qb.timestamps && k == qb.createdField {
+				continue
+			}
+			if containsString(keyColumns, k) {
+				continue
+			}
+			updateColumns = append(updateColumns, k)
+		}
+		if len(updateColumns) == 0 {
+			return "", nil, fmt.Errorf("upsert has no non-key column to update")
+		}
+	}
+	updates := make([]string, len(updateColumns))
+	for i, column := range updateColumns {
+		updates[i] = fmt.Sprintf("%s = VALUES(%s)", column, column)
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s",
+		qb.table, strings.Join(keys, ", "), strings.Join(values, ", "), strings.Join(updates, ", "))
+	return query, args, nil
+}
+
+// fillTimestamps 按需写入时间戳字段，返回新的数据，不修改原数据
+func (qb *Model) fillTimestamps(data map[string]interface{}, isInsert bool) map[string]interface{} {
+	if !qb.timestamps {
+		return data
+	}
+	var (
+		now    = gtime.Now().Format(qb.db.GetTimeFormat())
+		result = make(map[string]interface{}, len(data)+2)
+	)
+	for k, v := range data {
+		result[k] = v
+	}
+	if _, ok := result[qb.createdField]; isInsert && qb.createdField != "" && !ok {
+		result[qb.createdField] = now
+	}
+	if _, ok := result[qb.updatedField]; qb.updatedField != "" && !ok {
+		result[qb.updatedField] = now
+	}
+	return result
+}
+
+// sortedKeys 获取排好序的字段名，保证生成的SQL稳定
+func sortedKeys(data map[string]interface{}) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// containsString 判断字符串切片中是否包含指定字符串
+func containsString(items []string, item string) bool {
+	for _, v := range items {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+// buildInsert 构建INSERT语句
+func (qb *Model) buildInsert(data map[string]interface{}) (string, []interface{}) {
+	var (
+		keys         = sortedKeys(data)
+		placeholders = make([]string, len(keys))
+		args         = make([]interface{}, len(keys))
+	)
+	for i, k := range keys {
+		placeholders[i] = "?"
+		args[i] = data[k]
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		qb.table, strings.Join(keys, ", "), strings.Join(placeholders, ", "))
+	return query, args
+}
+
+// buildUpdate 构建UPDATE语句
+func (qb *Model) buildUpdate(data map[string]interface{}) (string, []interface{}) {
+	var (
+		sql  strings.Builder
+		keys = sortedKeys(data)
+		sets = make([]string, len(keys))
+		args = make([]interface{}, 0, len(keys))
+	)
+	for i, k := range keys {
+		sets[i] = k + " = ?"
+		args = append(args, data[k])
+	}
+	sql.WriteString("UPDATE ")
+	sql.WriteString(qb.table)
+	sql.WriteString(" SET ")
+	sql.WriteString(strings.Join(sets, ", "))
+	args = append(args, qb.buildWhere(&sql)...)
+	return sql.String(), args
+}
+
+// buildWhere 构建WHERE子句
+// 设置了软删除字段时，用户条件会被括号包裹后再与软删除条件组合，
+// 避免用户条件中的OR越过软删除条件
+func (qb *Model) buildWhere(sql *strings.Builder) []interface{} {
+	var (
+		args       []interface{}
+		softDelete = qb.softDeleteCondition()
+	)
+	if len(qb.where) == 0 && softDelete == "" {
+		return args
+	}
+	sql.WriteString(" WHERE ")
 	if len(qb.where) > 0 {
-		sql.WriteString(" WHERE ")
+		if softDelete != "" {
+			sql.WriteString("(")
+		}
 		for i, where := range qb.where {
 			if i > 0 || where.operator != "" {
 				sql.WriteString(" ")
@@ -638,23 +1362,56 @@ func (qb *Model) buildQuery() (string, []interface{}) {
 			sql.WriteString(where.cond)
 			args = append(args, where.args...)
 		}
+		if softDelete != "" {
+			sql.WriteString(") AND ")
+		}
 	}
+	sql.WriteString(softDelete)
+	return args
+}
 
-	// GROUP BY 子句
-	if len(qb.groupBy) > 0 {
-		sql.WriteString(" GROUP BY ")
-		sql.WriteString(strings.Join(qb.groupBy, ", "))
+// softDeleteCondition 获取软删除条件，未设置软删除或包含已删除记录时返回空字符串
+func (qb *Model) softDeleteCondition() string {
+	if qb.softDeleteField == "" || qb.trashed == trashedInclude {
+		return ""
+	}
+	field := qb.softDeleteField
+	if !strings.Contains(field, ".") {
+		// 使用别名或表名限定字段，避免关联查询时字段歧义
+		if qb.alias != "" {
+			field = qb.alias + "." + field
+		} else if len(qb.joins) > 0 {
+			field = qb.table + "." + field
+		}
+	}
+	if qb.trashed == trashedOnly {
+		return field + " IS NOT NULL"
 	}
+	return field + " IS NULL"
+}
 
-	// HAVING 子句
-	if len(qb.having) > 0 {
-		sql.WriteString(" HAVING ")
-		for i, having := range qb.having {
-			if i > 0 {
-				sql.WriteString(" AND ")
+// buildQuery 构建SQL查询
+func (qb *Model) buildQuery() (string, []interface{}) {
+	var sql strings.Builder
+	args := qb.buildSelect(&sql)
+
+	// UNION 子句
+	if len(qb.unions) > 0 {
+		query := sql.String()
+		sql.Reset()
+		sql.WriteString("(")
+		sql.WriteString(query)
+		sql.WriteString(")")
+		for _, union := range qb.unions {
+			if union.all {
+				sql.WriteString(" UNION ALL (")
+			} else {
+				sql.WriteString(" UNION (")
 			}
-			sql.WriteString(having.cond)
-			args = append(args, having.args...)
+			unionQuery, unionArgs := union.model.buildQuery()
+			sql.WriteString(unionQuery)
+			sql.WriteString(")")
+			args = append(args, unionArgs...)
 		}
 	}
 
@@ -683,8 +1440,8 @@ func (qb *Model) buildQuery() (string, []interface{}) {
 		sql.WriteString(fmt.Sprintf("%d", qb.offset))
 	}
 
-	// 锁
-	if qb.lockMode != "" {
+	// 锁（联合查询不支持加锁）
+	if qb.lockMode != "" && len(qb.unions) == 0 {
 		sql.WriteString(" ")
 		sql.WriteString(qb.lockMode)
 	}
@@ -692,6 +1449,81 @@ func (qb *Model) buildQuery() (string, []interface{}) {
 	return sql.String(), args
 }
 
+// buildFieldsQuery 构建只查询字段 fields 的SQL，用于统计、聚合及取值查询
+// 存在联合查询时不能只替换第一个查询的字段，因此将整个联合查询作为子查询，在其结果上查询 fields
+func (qb *Model) buildFieldsQuery(fields ...string) (string, []interface{}) {
+	if len(qb.unions) == 0 {
+		qb.fields = fields
+		return qb.buildQuery()
+	}
+	query, args := qb.buildQuery()
+	return fmt.Sprintf("SELECT %s FROM (%s) AS t", strings.Join(fields, ", "), query), args
+}
+
+// buildSelect 构建SELECT语句主体（不含ORDER BY、LIMIT、OFFSET及锁）
+func (qb *Model) buildSelect(sql *strings.Builder) []interface{} {
+	var args []interface{}
+
+	// SELECT 子句
+	sql.WriteString("SELECT ")
+	if qb.distinct {
+		sql.WriteString("DISTINCT ")
+	}
+	sql.WriteString(strings.Join(qb.fields, ", "))
+
+	// FROM 子句
+	sql.WriteString(" FROM ")
+	sql.WriteString(qb.table)
+	if qb.alias != "" {
+		sql.WriteString(" AS ")
+		sql.WriteString(qb.alias)
+	}
+
+	// 索引提示
+	for _, hint := range qb.indexHints {
+		sql.WriteString(" ")
+		sql.WriteString(hint)
+	}
+
+	// JOIN 子句
+	for _, join := range qb.joins {
+		sql.WriteString(" ")
+		sql.WriteString(join.joinType)
+		sql.WriteString(" JOIN ")
+		sql.WriteString(join.table)
+		if join.alias != "" {
+			sql.WriteString(" AS ")
+			sql.WriteString(join.alias)
+		}
+		sql.WriteString(" ON ")
+		sql.WriteString(join.on)
+		args = append(args, join.args...)
+	}
+
+	// WHERE 子句
+	args = append(args, qb.buildWhere(sql)...)
+
+	// GROUP BY 子句
+	if len(qb.groupBy) > 0 {
+		sql.WriteString(" GROUP BY ")
+		sql.WriteString(strings.Join(qb.groupBy, ", "))
+	}
+
+	// HAVING 子句
+	if len(qb.having) > 0 {
+		sql.WriteString(" HAVING ")
+		for i, having := range qb.having {
+			if i > 0 {
+				sql.WriteString(" AND ")
+			}
+			sql.WriteString(having.cond)
+			args = append(args, having.args...)
+		}
+	}
+
+	return args
+}
+
 // isSliceEmpty 辅助方法：判断切片是否为空
 func (r *QueryResult) isSliceEmpty(v interface{}) bool {
 	// 这里可以添加更多的反射逻辑来判断不同类型的空值
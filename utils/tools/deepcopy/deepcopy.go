@@ -4,6 +4,7 @@
 package deepcopy
 
 import (
+	"fmt"
 	"reflect"
 	"time"
 )
@@ -18,6 +19,8 @@ type Interface interface {
 // Copy 无法复制结构体中未导出的字段（字段名为小写）。
 // 未导出的字段无法被Go运行时反射，因此
 // 他们无法执行任何数据复制。
+//
+// 通道会被复制为相同类型和容量的新通道，但其中已缓冲的数据不会被复制。
 func Copy(src interface{}) interface{} {
 	if src == nil {
 		return nil
@@ -43,15 +46,52 @@ func Copy(src interface{}) interface{} {
 			dst      = reflect.New(original.Type()).Elem() // 产生一个与 original 类型相同的副本
 		)
 		// 递归复制原始值。
-		copyRecursive(original, dst)
+		copyRecursive(original, dst, make(map[visitKey]reflect.Value))
 		// 返回副本作为接口。
 		return dst.Interface()
 	}
 }
 
+// CopyInto 将 src 深度拷贝后赋值给指针 dst 指向的值，避免调用方对 Copy 的返回值做类型断言。
+// 如果 src 是指向 dst 所指类型的指针，则赋值其指向的值。src 实现了 Interface 时使用其 DeepCopy 方法。
+// dst 不是非 nil 指针或类型不兼容时返回错误；src 为 nil 时将 *dst 置为零值。
+func CopyInto(src, dst interface{}) error {
+	dstValue := reflect.ValueOf(dst)
+	if dstValue.Kind() != reflect.Ptr || dstValue.IsNil() {
+		return fmt.Errorf("deepcopy: destination must be a non-nil pointer, but got %T", dst)
+	}
+	target := dstValue.Elem()
+	if src == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	copyValue := reflect.ValueOf(Copy(src))
+	switch {
+	case copyValue.Type().AssignableTo(target.Type()):
+		target.Set(copyValue)
+	case copyValue.Kind() == reflect.Ptr && !copyValue.IsNil() && copyValue.Elem().Type().AssignableTo(target.Type()):
+		target.Set(copyValue.Elem())
+	default:
+		return fmt.Errorf("deepcopy: cannot assign %T to destination of type %s", src, target.Type())
+	}
+	return nil
+}
+
+// visitKey 用于标识已经复制过的引用值（指针、切片、映射、通道）。
+// 同一地址在不同类型下可能代表不同的值，因此需要同时记录类型；
+// 切片还需记录长度，避免不同长度的子切片共用同一个副本。
+type visitKey struct {
+	typ  reflect.Type
+	ptr  uintptr
+	size int
+}
+
 // copyRecursive 递归复制原始值到副本中。
 // 它目前对可处理的类型有限制。根据需要添加。
-func copyRecursive(original, cpy reflect.Value) {
+//
+// visited 记录了已经复制过的引用值及其副本，
+// 当再次遇到同一地址时直接复用已有副本，从而保留循环引用并避免无限递归。
+func copyRecursive(original, cpy reflect.Value, visited map[visitKey]reflect.Value) {
 	// 检查是否实现了 deepcopy.Interface 接口。
 	if original.CanInterface() && original.IsValid() && !original.IsZero() {
 		if copier, ok := original.Interface().(Interface); ok {
@@ -70,8 +110,14 @@ func copyRecursive(original, cpy reflect.Value) {
 		if !originalValue.IsValid() {
 			return
 		}
+		key := visitKey{typ: original.Type(), ptr: original.Pointer()}
+		if v, ok := visited[key]; ok {
+			cpy.Set(v)
+			return
+		}
 		cpy.Set(reflect.New(originalValue.Type()))
-		copyRecursive(originalValue, cpy.Elem())
+		visited[key] = cpy
+		copyRecursive(originalValue, cpy.Elem(), visited)
 
 	case reflect.Interface:
 		// 如果这是一个 nil，直接返回。
@@ -83,7 +129,7 @@ func copyRecursive(original, cpy reflect.Value) {
 
 		// 获取值并调用 Elem()。
 		copyValue := reflect.New(originalValue.Type()).Elem()
-		copyRecursive(originalValue, copyValue)
+		copyRecursive(originalValue, copyValue, visited)
 		cpy.Set(copyValue)
 
 	case reflect.Struct:
@@ -102,32 +148,64 @@ func copyRecursive(original, cpy reflect.Value) {
 			if original.Type().Field(i).PkgPath != "" {
 				continue
 			}
-			copyRecursive(original.Field(i), cpy.Field(i))
+			copyRecursive(original.Field(i), cpy.Field(i), visited)
 		}
 
 	case reflect.Slice:
 		if original.IsNil() {
 			return
 		}
+		key := visitKey{typ: original.Type(), ptr: original.Pointer(), size: original.Len()}
+		if v, ok := visited[key]; ok {
+			cpy.Set(v)
+			return
+		}
 		// 创建一个新的切片并复制每个元素。
 		cpy.Set(reflect.MakeSlice(original.Type(), original.Len(), original.Cap()))
+		visited[key] = cpy
 		for i := 0; i < original.Len(); i++ {
-			copyRecursive(original.Index(i), cpy.Index(i))
+			copyRecursive(original.Index(i), cpy.Index(i), visited)
 		}
 
 	case reflect.Map:
 		if original.IsNil() {
 			return
 		}
+		mapKey := visitKey{typ: original.Type(), ptr: original.Pointer()}
+		if v, ok := visited[mapKey]; ok {
+			cpy.Set(v)
+			return
+		}
 		cpy.Set(reflect.MakeMap(original.Type()))
+		visited[mapKey] = cpy
 		for _, key := range original.MapKeys() {
 			originalValue := original.MapIndex(key)
 			copyValue := reflect.New(originalValue.Type()).Elem()
-			copyRecursive(originalValue, copyValue)
+			copyRecursive(originalValue, copyValue, visited)
 			copyKey := Copy(key.Interface())
 			cpy.SetMapIndex(reflect.ValueOf(copyKey), copyValue)
 		}
 
+	case reflect.Array:
+		// 数组是值类型，逐个深度复制元素，避免元素中的引用值被共享。
+		for i := 0; i < original.Len(); i++ {
+			copyRecursive(original.Index(i), cpy.Index(i), visited)
+		}
+
+	case reflect.Chan:
+		// 创建一个相同类型和容量的新通道，而不是与原通道共用。
+		// 注意：通道中已缓冲的数据不会被复制。
+		if original.IsNil() {
+			return
+		}
+		key := visitKey{typ: original.Type(), ptr: original.Pointer()}
+		if v, ok := visited[key]; ok {
+			cpy.Set(v)
+			return
+		}
+		cpy.Set(reflect.MakeChan(original.Type(), original.Cap()))
+		visited[key] = cpy
+
 	default:
 		cpy.Set(original)
 	}
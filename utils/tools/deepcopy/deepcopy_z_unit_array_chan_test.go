@@ -0,0 +1,76 @@
+package deepcopy_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
+)
+
+func TestCopy_Array(t *testing.T) {
+	type item struct {
+		Tags []string
+	}
+	type holder struct {
+		Scores [3]int
+		Items  [2]*item
+		Lists  [2][]int
+	}
+	src := holder{
+		Scores: [3]int{1, 2, 3},
+		Items:  [2]*item{{Tags: []string{"a"}}, nil},
+		Lists:  [2][]int{{1, 2}, {3}},
+	}
+	cpy := deepcopy.Copy(src).(holder)
+	if cpy.Scores != src.Scores || cpy.Items[0].Tags[0] != "a" || cpy.Items[1] != nil || cpy.Lists[0][1] != 2 {
+		t.Fatalf("copy = %+v, want the same content as %+v", cpy, src)
+	}
+
+	// Mutating elements of the copy leaves the source intact.
+	cpy.Scores[0] = 100
+	cpy.Items[0].Tags[0] = "changed"
+	cpy.Lists[0][0] = 100
+	if src.Scores[0] != 1 {
+		t.Errorf("source array element = %d, want 1", src.Scores[0])
+	}
+	if cpy.Items[0] == src.Items[0] || src.Items[0].Tags[0] != "a" {
+		t.Error("pointer elements of the array should be deep copied")
+	}
+	if src.Lists[0][0] != 1 {
+		t.Error("slice elements of the array should be deep copied")
+	}
+}
+
+func TestCopy_Chan(t *testing.T) {
+	type holder struct {
+		Ch    chan int
+		Same  chan int
+		NilCh chan string
+	}
+	ch := make(chan int, 3)
+	ch <- 1
+	src := holder{Ch: ch, Same: ch}
+	cpy := deepcopy.Copy(src).(holder)
+
+	if cpy.Ch == nil || cpy.Ch == src.Ch {
+		t.Fatal("copied channel should be a distinct channel")
+	}
+	if cap(cpy.Ch) != 3 {
+		t.Errorf("copied channel capacity = %d, want 3", cap(cpy.Ch))
+	}
+	// Buffered contents are not copied.
+	if len(cpy.Ch) != 0 || len(src.Ch) != 1 {
+		t.Errorf("len(copy) = %d, len(source) = %d, want 0 and 1", len(cpy.Ch), len(src.Ch))
+	}
+	// The same source channel is copied to the same new channel.
+	if cpy.Same != cpy.Ch {
+		t.Error("references to the same channel should share one copy")
+	}
+	if cpy.NilCh != nil {
+		t.Error("nil channel should stay nil")
+	}
+
+	cpy.Ch <- 2
+	if len(src.Ch) != 1 {
+		t.Error("sending to the copy should not affect the source channel")
+	}
+}
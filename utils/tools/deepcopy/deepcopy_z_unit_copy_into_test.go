@@ -0,0 +1,78 @@
+package deepcopy_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
+)
+
+type intoUser struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+}
+
+// intoCustom implements deepcopy.Interface with a marker to detect its usage.
+type intoCustom struct {
+	Value  int
+	Copied bool
+}
+
+func (c intoCustom) DeepCopy() interface{} {
+	return intoCustom{Value: c.Value, Copied: true}
+}
+
+func TestCopyInto(t *testing.T) {
+	src := intoUser{Name: "john", Tags: []string{"a"}, Attrs: map[string]int{"age": 18}}
+	var dst intoUser
+	if err := deepcopy.CopyInto(src, &dst); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dst, src) {
+		t.Errorf("CopyInto = %+v, want %+v", dst, src)
+	}
+	dst.Tags[0] = "z"
+	dst.Attrs["age"] = 20
+	if src.Tags[0] != "a" || src.Attrs["age"] != 18 {
+		t.Errorf("source was modified through the copy: %+v", src)
+	}
+
+	// A pointer source is dereferenced into a value destination.
+	var fromPtr intoUser
+	if err := deepcopy.CopyInto(&src, &fromPtr); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromPtr, src) {
+		t.Errorf("CopyInto from pointer = %+v, want %+v", fromPtr, src)
+	}
+
+	var custom intoCustom
+	if err := deepcopy.CopyInto(intoCustom{Value: 1}, &custom); err != nil {
+		t.Fatal(err)
+	}
+	if !custom.Copied || custom.Value != 1 {
+		t.Errorf("CopyInto should use the DeepCopy method, got %+v", custom)
+	}
+
+	var cleared = intoUser{Name: "x"}
+	if err := deepcopy.CopyInto(nil, &cleared); err != nil || !reflect.DeepEqual(cleared, intoUser{}) {
+		t.Errorf("CopyInto(nil) = %+v, %v, want zero value", cleared, err)
+	}
+}
+
+func TestCopyInto_Errors(t *testing.T) {
+	var (
+		src = intoUser{Name: "john"}
+		str string
+	)
+	if err := deepcopy.CopyInto(src, &str); err == nil {
+		t.Error("CopyInto with a mismatched destination type should fail")
+	}
+	if err := deepcopy.CopyInto(src, intoUser{}); err == nil {
+		t.Error("CopyInto with a non-pointer destination should fail")
+	}
+	if err := deepcopy.CopyInto(src, (*intoUser)(nil)); err == nil {
+		t.Error("CopyInto with a nil pointer destination should fail")
+	}
+}
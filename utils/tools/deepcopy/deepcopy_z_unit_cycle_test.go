@@ -0,0 +1,72 @@
+package deepcopy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
+)
+
+type cycleNode struct {
+	Name string
+	Next *cycleNode
+}
+
+func TestCopy_Cycle(t *testing.T) {
+	a := &cycleNode{Name: "a"}
+	b := &cycleNode{Name: "b", Next: a}
+	a.Next = b
+
+	done := make(chan interface{})
+	go func() {
+		done <- deepcopy.Copy(a)
+	}()
+	var result interface{}
+	select {
+	case result = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Copy did not return on a cyclic value")
+	}
+
+	copyA := result.(*cycleNode)
+	if copyA == a || copyA.Next == b {
+		t.Fatal("copied nodes should not point to the originals")
+	}
+	if copyA.Name != "a" || copyA.Next.Name != "b" {
+		t.Errorf("copied names = %q, %q", copyA.Name, copyA.Next.Name)
+	}
+	if copyA.Next.Next != copyA {
+		t.Error("copied cycle should point back to the copied head")
+	}
+}
+
+func TestCopy_SharedPointer(t *testing.T) {
+	type pair struct {
+		Left, Right *cycleNode
+	}
+	shared := &cycleNode{Name: "shared"}
+	cpy := deepcopy.Copy(pair{Left: shared, Right: shared}).(pair)
+	if cpy.Left == shared {
+		t.Fatal("copied pointer should not be the original")
+	}
+	if cpy.Left != cpy.Right {
+		t.Error("a pointer shared in the source should stay shared in the copy")
+	}
+}
+
+func TestCopy_SelfReferencingMap(t *testing.T) {
+	m := map[string]interface{}{"name": "root"}
+	m["self"] = m
+	cpy := deepcopy.Copy(m).(map[string]interface{})
+	self, ok := cpy["self"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("self = %T", cpy["self"])
+	}
+	self["name"] = "changed"
+	if m["name"] != "root" {
+		t.Error("mutating the copy changed the source")
+	}
+	if cpy["name"] != "changed" {
+		t.Error("copied map should reference itself")
+	}
+}
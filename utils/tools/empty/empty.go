@@ -4,6 +4,7 @@ package empty
 import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/reflection"
 	"reflect"
+	"sync"
 	"time"
 )
 
@@ -27,6 +28,46 @@ type iTime interface {
 	IsZero() bool
 }
 
+var (
+	// checkerMap 存储自定义类型的空值检查函数，键为类型。
+	checkerMap = make(map[reflect.Type]func(interface{}) bool)
+	// checkerMu 保证 checkerMap 的并发安全。
+	checkerMu sync.RWMutex
+)
+
+// RegisterEmptyChecker 为类型 `t` 注册自定义的空值检查函数 `f`。
+// 注册后，IsEmpty 在反射分支中会优先使用 `f` 判断该类型的值是否为空，
+// 而不再使用内置的接口检查和类型判断。重复注册同一类型会覆盖之前的检查函数，
+// 传入 nil 的 `f` 则会移除该类型已注册的检查函数。
+func RegisterEmptyChecker(t reflect.Type, f func(interface{}) bool) {
+	checkerMu.Lock()
+	defer checkerMu.Unlock()
+	if f == nil {
+		delete(checkerMap, t)
+		return
+	}
+	checkerMap[t] = f
+}
+
+// checkRegistered 使用已注册的检查函数检查 `rv` 是否为空，
+// 第二个返回值表示是否存在对应类型的检查函数。
+func checkRegistered(rv reflect.Value) (isEmpty bool, ok bool) {
+	if !rv.IsValid() {
+		return false, false
+	}
+	checkerMu.RLock()
+	if len(checkerMap) == 0 {
+		checkerMu.RUnlock()
+		return false, false
+	}
+	f, ok := checkerMap[rv.Type()]
+	checkerMu.RUnlock()
+	if !ok || !rv.CanInterface() {
+		return false, false
+	}
+	return f(rv.Interface()), true
+}
+
 // IsEmpty 检查给定的 `value` 是否为空。
 // 如果 `value` 是以下类型之一，它将返回 true：0, nil, false, "", len(slice/map/chan) == 0,
 // 否则它将返回 false。
@@ -92,8 +133,14 @@ func IsEmpty(value interface{}, traceSource ...bool) bool {
 		var rv reflect.Value
 		if v, ok := value.(reflect.Value); ok {
 			rv = v
+			if isEmpty, ok := checkRegistered(rv); ok {
+				return isEmpty
+			}
 		} else {
 			rv = reflect.ValueOf(value)
+			if isEmpty, ok := checkRegistered(rv); ok {
+				return isEmpty
+			}
 			if IsNil(rv) {
 				return true
 			}
@@ -194,6 +241,65 @@ func IsEmpty(value interface{}, traceSource ...bool) bool {
 	}
 }
 
+// IsZero 检查给定的 `value` 是否为其类型的零值，语义与 reflect.Value.IsZero 一致。
+//
+// 它与 IsEmpty 的区别在于：IsEmpty 将长度为 0 的集合也视为空，
+// 而 IsZero 只在值等于类型零值时返回 true。例如：
+// 长度为 0 但非 nil 的切片/映射不是零值，IsZero 返回 false，IsEmpty 返回 true；
+// 结构体只有在所有字段都为零值时才是零值；nil 指针是零值。
+func IsZero(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	var rv reflect.Value
+	if v, ok := value.(reflect.Value); ok {
+		rv = v
+	} else {
+		rv = reflect.ValueOf(value)
+	}
+	if !rv.IsValid() {
+		return true
+	}
+	return rv.IsZero()
+}
+
+// AllEmpty 检查给定的所有 `values` 是否都为空，判断规则与 IsEmpty 相同。
+// 没有给定任何值时返回 true。
+//
+// 注意：它不会跟踪指针指向的源变量（相当于 IsEmpty 的 `traceSource` 为 false），
+// 如需跟踪请对每个值单独调用 IsEmpty。
+func AllEmpty(values ...interface{}) bool {
+	for _, value := range values {
+		if !IsEmpty(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyEmpty 检查给定的 `values` 中是否有任意一个为空，判断规则与 IsEmpty 相同。
+// 没有给定任何值时返回 false。也请参阅 AllEmpty。
+func AnyEmpty(values ...interface{}) bool {
+	for _, value := range values {
+		if IsEmpty(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountEmpty 返回给定的 `values` 中为空的值的数量，判断规则与 IsEmpty 相同。
+// 也请参阅 AllEmpty。
+func CountEmpty(values ...interface{}) int {
+	count := 0
+	for _, value := range values {
+		if IsEmpty(value) {
+			count++
+		}
+	}
+	return count
+}
+
 // IsNil 函数用于检查给定的 `value` 是否为 nil，尤其是对于 interface{} 类型的值。
 // 如果给定的`value`是指针类型，则参数`traceSource`用于追踪到源变量
 // 这也指向一个指针。如果`traceSource`为true时源为nil，则返回nil。
@@ -0,0 +1,42 @@
+package empty_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
+)
+
+func TestAllAnyCountEmpty(t *testing.T) {
+	var (
+		emptyStr = ""
+		nilPtr   *int
+	)
+	tests := []struct {
+		values []interface{}
+		all    bool
+		any    bool
+		count  int
+	}{
+		{nil, true, false, 0},
+		{[]interface{}{"", 0, nil, []int{}, map[string]int{}, false}, true, true, 6},
+		{[]interface{}{"a", 1, []int{1}, true}, false, false, 0},
+		{[]interface{}{"", "a", 0, 1}, false, true, 2},
+		{[]interface{}{nilPtr}, true, true, 1},
+		// 指针不会被跟踪到其指向的源变量。
+		{[]interface{}{&emptyStr}, false, false, 0},
+	}
+	for _, tt := range tests {
+		if got := empty.AllEmpty(tt.values...); got != tt.all {
+			t.Errorf("AllEmpty(%v) = %v, want %v", tt.values, got, tt.all)
+		}
+		if got := empty.AnyEmpty(tt.values...); got != tt.any {
+			t.Errorf("AnyEmpty(%v) = %v, want %v", tt.values, got, tt.any)
+		}
+		if got := empty.CountEmpty(tt.values...); got != tt.count {
+			t.Errorf("CountEmpty(%v) = %v, want %v", tt.values, got, tt.count)
+		}
+	}
+	if !empty.IsEmpty(&emptyStr, true) {
+		t.Error("IsEmpty with traceSource should trace the pointer to the empty string")
+	}
+}
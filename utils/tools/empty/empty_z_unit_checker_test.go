@@ -0,0 +1,52 @@
+package empty_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+// money 是一个零值不代表空的自定义类型，负数表示未设置。
+type money struct {
+	Cents int64
+}
+
+func TestRegisterEmptyChecker(t *testing.T) {
+	moneyType := reflect.TypeOf(money{})
+	if !empty.IsEmpty(money{}) {
+		t.Fatal("zero struct should be empty before registering a checker")
+	}
+
+	empty.RegisterEmptyChecker(moneyType, func(v interface{}) bool {
+		return v.(money).Cents < 0
+	})
+	t.Cleanup(func() { empty.RegisterEmptyChecker(moneyType, nil) })
+
+	if empty.IsEmpty(money{}) {
+		t.Error("zero money should not be empty with the registered checker")
+	}
+	if !empty.IsEmpty(money{Cents: -1}) {
+		t.Error("negative money should be empty with the registered checker")
+	}
+	if !empty.IsEmpty(nil) || !empty.IsEmpty("") {
+		t.Error("other types should keep the built-in rules")
+	}
+
+	m := gmap.NewFrom(map[interface{}]interface{}{
+		"free":  money{},
+		"unset": money{Cents: -1},
+		"price": money{Cents: 100},
+		"blank": "",
+	})
+	m.FilterEmpty()
+	if m.Size() != 2 || !m.Contains("free") || !m.Contains("price") {
+		t.Errorf("FilterEmpty kept %v, want free and price", m.Keys())
+	}
+
+	empty.RegisterEmptyChecker(moneyType, nil)
+	if !empty.IsEmpty(money{}) {
+		t.Error("removing the checker should restore the built-in rules")
+	}
+}
@@ -0,0 +1,43 @@
+package empty_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
+)
+
+func TestIsZero(t *testing.T) {
+	type user struct {
+		Name  string
+		Roles []string
+	}
+	var nilUser *user
+	tests := []struct {
+		name    string
+		value   interface{}
+		isZero  bool
+		isEmpty bool
+	}{
+		{"nil", nil, true, true},
+		{"empty non-nil slice", []int{}, false, true},
+		{"empty non-nil map", map[string]int{}, false, true},
+		{"nil slice", []int(nil), true, true},
+		{"zero struct", user{}, true, true},
+		{"struct with empty slice", user{Roles: []string{}}, false, true},
+		{"non-zero struct", user{Name: "john"}, false, false},
+		{"nil pointer", nilUser, true, true},
+		{"pointer to zero struct", &user{}, false, false},
+		{"zero int", 0, true, true},
+		{"empty string", "", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := empty.IsZero(tt.value); got != tt.isZero {
+				t.Errorf("IsZero = %v, want %v", got, tt.isZero)
+			}
+			if got := empty.IsEmpty(tt.value); got != tt.isEmpty {
+				t.Errorf("IsEmpty = %v, want %v", got, tt.isEmpty)
+			}
+		})
+	}
+}
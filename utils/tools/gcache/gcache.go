@@ -113,6 +113,22 @@ func GetOrSetFuncLock(ctx context.Context, key interface{}, f Func, duration tim
 	return defaultCache.GetOrSetFuncLock(ctx, key, f, duration)
 }
 
+// GetOrSetFuncWithError 检索并返回 `key` 的值，或者用函数 `f` 的结果设置 `key`。
+// 当 `f` 返回 nil 值时，会缓存一个负缓存标记 `negativeTTL` 时间，期间不会再次调用 `f`。
+func GetOrSetFuncWithError(ctx context.Context, key interface{}, f Func, duration, negativeTTL time.Duration) (*gvar.Var, error) {
+	return defaultCache.GetOrSetFuncWithError(ctx, key, f, duration, negativeTTL)
+}
+
+// GetOrSetMany 批量检索并返回 `keys` 的值，缺失的键只调用一次函数 `f` 计算并写入缓存。
+// 也请参阅 Cache.GetOrSetMany。
+func GetOrSetMany(
+	ctx context.Context, keys []interface{},
+	f func(ctx context.Context, missing []interface{}) (map[interface{}]interface{}, error),
+	duration time.Duration,
+) (map[interface{}]*gvar.Var, error) {
+	return defaultCache.GetOrSetMany(ctx, keys, f, duration)
+}
+
 // 包含检查，如果`key`存在于缓存中，则返回true，否则返回false。
 func Contains(ctx context.Context, key interface{}) (bool, error) {
 	return defaultCache.Contains(ctx, key)
@@ -138,6 +154,29 @@ func Removes(ctx context.Context, keys []interface{}) error {
 	return defaultCache.Removes(ctx, keys)
 }
 
+// Rename 将 `oldKey` 的值移动到 `newKey`，并保留其剩余的过期时间。
+// 如果 `oldKey` 不存在于缓存中，则返回 false 且不做任何操作。
+func Rename(ctx context.Context, oldKey, newKey interface{}) (bool, error) {
+	return defaultCache.Rename(ctx, oldKey, newKey)
+}
+
+// Increment 原子地将 `key` 的整数值增加 `delta`，并返回新值。
+// 不存在的键视为 0，已存在的键保留其过期时间。
+func Increment(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	return defaultCache.Increment(ctx, key, delta)
+}
+
+// Decrement 原子地将 `key` 的整数值减少 `delta`，并返回新值。
+func Decrement(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	return defaultCache.Decrement(ctx, key, delta)
+}
+
+// Lock 尝试获取以 `key` 标识的锁，锁在 `ttl` 后自动过期，获取成功时返回用于释放锁的函数 `release`。
+// 默认缓存使用内存适配器，只能在当前进程内互斥。也请参阅 Cache.Lock。
+func Lock(ctx context.Context, key interface{}, ttl time.Duration) (acquired bool, release func(), err error) {
+	return defaultCache.Lock(ctx, key, ttl)
+}
+
 // `Update` 函数用于更新 `key` 的值，但不改变其过期时间，并返回旧值。
 // 如果缓存中不存在`key`，则返回值`exist`为false。
 //
@@ -172,6 +211,12 @@ func Keys(ctx context.Context) ([]interface{}, error) {
 	return defaultCache.Keys(ctx)
 }
 
+// KeysByPattern 返回缓存中字符串形式匹配通配符 `pattern` 的所有键。
+// 也请参阅 Cache.KeysByPattern。
+func KeysByPattern(ctx context.Context, pattern string) ([]interface{}, error) {
+	return defaultCache.KeysByPattern(ctx, pattern)
+}
+
 // KeyStrings 返回缓存中的所有键作为字符串切片。
 func KeyStrings(ctx context.Context) ([]string, error) {
 	return defaultCache.KeyStrings(ctx)
@@ -0,0 +1,349 @@
+package gcache
+
+import (
+	"context"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtime"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtype"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// AdapterFile 是一个将缓存数据持久化到磁盘文件的适配器。
+//
+// 它在内存适配器的基础上，将所有未过期的缓存项以快照的形式写入单个文件，
+// 创建时从文件加载数据，修改操作后延迟合并写入，关闭时立即写入。
+// 值使用 json 序列化，因此从文件恢复后，键会变为字符串，结构体等复杂值会变为 map 等基础类型。
+//
+// 注意：每次写入都会重写整个文件，它适用于数据量较小、需要在重启后保留的缓存。
+type AdapterFile struct {
+	*AdapterMemory               // AdapterMemory 保存内存中的缓存数据。
+	path           string        // path 是持久化文件的路径。
+	mu             sync.Mutex    // mu 保证写入文件的并发安全性。
+	dirty          *gtype.Bool   // dirty 标记是否有尚未写入文件的修改。
+	flushDelay     time.Duration // flushDelay 是修改后延迟写入文件的时间，用于合并多次写入。
+}
+
+// fileCacheItem 是缓存项在文件中的存储结构。
+type fileCacheItem struct {
+	K string          `json:"k"` // 键。
+	V json.RawMessage `json:"v"` // json 序列化后的值。
+	E int64           `json:"e"` // 过期时间（毫秒），0 表示永不过期。
+}
+
+const (
+	// defaultFileFlushDelay 是修改后延迟写入文件的默认时间。
+	defaultFileFlushDelay = time.Second
+)
+
+// NewAdapterFile 创建并返回一个将数据持久化到文件 `path` 的适配器。
+// 如果文件已存在，则从文件中加载未过期的缓存项。
+func NewAdapterFile(path string) (*AdapterFile, error) {
+	c := &AdapterFile{
+		AdapterMemory: doNewAdapterMemory(),
+		path:          path,
+		dirty:         gtype.NewBool(),
+		flushDelay:    defaultFileFlushDelay,
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SetFlushDelay 设置修改后延迟写入文件的时间，<= 0 表示每次修改后立即写入。
+func (c *AdapterFile) SetFlushDelay(delay time.Duration) {
+	c.flushDelay = delay
+}
+
+// Set 使用 `key`-`value` 对设置缓存，在 `duration` 时间后过期。
+// 如果 `value` 无法使用 json 序列化，则返回错误。
+func (c *AdapterFile) Set(ctx context.Context, key interface{}, value interface{}, duration time.Duration) error {
+	if err := checkFileValue(key, value); err != nil {
+		return err
+	}
+	if err := c.AdapterMemory.Set(ctx, key, value, duration); err != nil {
+		return err
+	}
+	return c.markDirty()
+}
+
+// SetMap 批量设置缓存，使用 `data` 映射中的键值对，在 `duration` 时间后过期。
+// 如果任一值无法使用 json 序列化，则返回错误且不设置任何值。
+func (c *AdapterFile) SetMap(ctx context.Context, data map[interface{}]interface{}, duration time.Duration) error {
+	for k, v := range data {
+		if err := checkFileValue(k, v); err != nil {
+			return err
+		}
+	}
+	if err := c.AdapterMemory.SetMap(ctx, data, duration); err != nil {
+		return err
+	}
+	return c.markDirty()
+}
+
+// SetIfNotExist 仅在 `key` 不存在于缓存中时，使用 `key`-`value` 对设置缓存。
+func (c *AdapterFile) SetIfNotExist(ctx context.Context, key interface{}, value interface{}, duration time.Duration) (bool, error) {
+	if err := checkFileValue(key, value); err != nil {
+		return false, err
+	}
+	ok, err := c.AdapterMemory.SetIfNotExist(ctx, key, value, duration)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return ok, c.markDirty()
+}
+
+// SetIfNotExistFunc 仅在 `key` 不存在于缓存中时，使用函数 `f` 的结果设置 `key`。
+func (c *AdapterFile) SetIfNotExistFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (bool, error) {
+	ok, err := c.AdapterMemory.SetIfNotExistFunc(ctx, key, f, duration)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return ok, c.markDirty()
+}
+
+// SetIfNotExistFuncLock 仅在 `key` 不存在于缓存中时，在写锁内使用函数 `f` 的结果设置 `key`。
+func (c *AdapterFile) SetIfNotExistFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (bool, error) {
+	ok, err := c.AdapterMemory.SetIfNotExistFuncLock(ctx, key, f, duration)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return ok, c.markDirty()
+}
+
+// GetOrSet 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则设置 `key`-`value` 对并返回 `value`。
+// 命中缓存时不会触发写入文件。
+func (c *AdapterFile) GetOrSet(ctx context.Context, key interface{}, value interface{}, duration time.Duration) (*gvar.Var, error) {
+	if v, err := c.AdapterMemory.Get(ctx, key); err != nil || v != nil {
+		return v, err
+	}
+	if err := checkFileValue(key, value); err != nil {
+		return nil, err
+	}
+	v, err := c.AdapterMemory.GetOrSet(ctx, key, value, duration)
+	if err != nil {
+		return nil, err
+	}
+	return v, c.markDirty()
+}
+
+// GetOrSetFunc 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则使用函数 `f` 的结果设置 `key` 并返回其结果。
+// 命中缓存时不会触发写入文件。
+func (c *AdapterFile) GetOrSetFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
+	if v, err := c.AdapterMemory.Get(ctx, key); err != nil || v != nil {
+		return v, err
+	}
+	v, err := c.AdapterMemory.GetOrSetFunc(ctx, key, f, duration)
+	if err != nil {
+		return nil, err
+	}
+	return v, c.markDirty()
+}
+
+// GetOrSetFuncLock 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则在写锁内使用函数 `f` 的结果设置 `key` 并返回其结果。
+// 命中缓存时不会触发写入文件。
+func (c *AdapterFile) GetOrSetFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
+	if v, err := c.AdapterMemory.Get(ctx, key); err != nil || v != nil {
+		return v, err
+	}
+	v, err := c.AdapterMemory.GetOrSetFuncLock(ctx, key, f, duration)
+	if err != nil {
+		return nil, err
+	}
+	return v, c.markDirty()
+}
+
+// Remove 删除缓存中的一个或多个键，并返回其值。
+func (c *AdapterFile) Remove(ctx context.Context, keys ...interface{}) (*gvar.Var, error) {
+	v, err := c.AdapterMemory.Remove(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+	return v, c.markDirty()
+}
+
+// Update 更新 `key` 的值而不改变其过期时间，并返回旧值。
+// 如果 `value` 无法使用 json 序列化，则返回错误。
+func (c *AdapterFile) Update(ctx context.Context, key interface{}, value interface{}) (oldValue *gvar.Var, exist bool, err error) {
+	if err = checkFileValue(key, value); err != nil {
+		return nil, false, err
+	}
+	if oldValue, exist, err = c.AdapterMemory.Update(ctx, key, value); err != nil || !exist {
+		return
+	}
+	return oldValue, exist, c.markDirty()
+}
+
+// UpdateExpire 更新 `key` 的过期时间，并返回旧的过期时间值。
+func (c *AdapterFile) UpdateExpire(ctx context.Context, key interface{}, duration time.Duration) (oldDuration time.Duration, err error) {
+	if oldDuration, err = c.AdapterMemory.UpdateExpire(ctx, key, duration); err != nil || oldDuration == -1 {
+		return
+	}
+	return oldDuration, c.markDirty()
+}
+
+// Increment 原子地将 `key` 的整数值增加 `delta`，并返回新值。
+func (c *AdapterFile) Increment(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	v, err := c.AdapterMemory.Increment(ctx, key, delta)
+	if err != nil {
+		return 0, err
+	}
+	return v, c.markDirty()
+}
+
+// Decrement 原子地将 `key` 的整数值减少 `delta`，并返回新值。
+func (c *AdapterFile) Decrement(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	return c.Increment(ctx, key, -delta)
+}
+
+// Clear 清除缓存中的所有数据。
+func (c *AdapterFile) Clear(ctx context.Context) error {
+	if err := c.AdapterMemory.Clear(ctx); err != nil {
+		return err
+	}
+	return c.markDirty()
+}
+
+// ClearByPrefix 删除缓存中所有字符串形式以 `prefix` 开头的键，并返回删除的数量。
+func (c *AdapterFile) ClearByPrefix(ctx context.Context, prefix string) (removed int, err error) {
+	if removed, err = c.AdapterMemory.ClearByPrefix(ctx, prefix); err != nil || removed == 0 {
+		return
+	}
+	return removed, c.markDirty()
+}
+
+// Import 从 Export 导出的 JSON 快照 `data` 中恢复缓存项，返回导入的数量，并将导入的数据写入文件。
+// 也请参阅 AdapterMemory.Import。
+func (c *AdapterFile) Import(ctx context.Context, data []byte, overwrite bool) (imported int, err error) {
+	imported, err = c.AdapterMemory.Import(ctx, data, overwrite)
+	if imported == 0 {
+		return
+	}
+	// 导入中途出错时，已导入的项同样需要写入文件。
+	if dirtyErr := c.markDirty(); err == nil {
+		err = dirtyErr
+	}
+	return
+}
+
+// Close 将缓存数据立即写入文件，然后关闭缓存。
+func (c *AdapterFile) Close(ctx context.Context) error {
+	if err := c.Flush(); err != nil {
+		return err
+	}
+	return c.AdapterMemory.Close(ctx)
+}
+
+// Flush 立即将缓存中所有未过期的数据写入文件。
+// 写入时先写入临时文件再重命名，以避免写入过程中断导致文件损坏。
+func (c *AdapterFile) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dirty.Set(false)
+	var (
+		items    = c.data.Items()
+		fileData = make([]fileCacheItem, 0, len(items))
+	)
+	for k, item := range items {
+		value, err := json.Marshal(item.v)
+		if err != nil {
+			// 通过函数设置的值无法提前检查，不可序列化的值不写入文件。
+			continue
+		}
+		expire := item.e
+		if expire == defaultMaxExpire {
+			expire = 0
+		}
+		fileData = append(fileData, fileCacheItem{
+			K: gconv.String(k),
+			V: value,
+			E: expire,
+		})
+	}
+	content, err := json.Marshal(fileData)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), os.ModePerm); err != nil {
+		return gerror.Wrapf(err, `os.MkdirAll failed for path "%s"`, filepath.Dir(c.path))
+	}
+	tmpPath := c.path + ".tmp"
+	if err = os.WriteFile(tmpPath, content, 0666); err != nil {
+		return gerror.Wrapf(err, `os.WriteFile failed for path "%s"`, tmpPath)
+	}
+	if err = os.Rename(tmpPath, c.path); err != nil {
+		return gerror.Wrapf(err, `os.Rename failed from "%s" to "%s"`, tmpPath, c.path)
+	}
+	return nil
+}
+
+// markDirty 标记缓存有未写入的修改，并在 flushDelay 后写入文件。
+// 在等待写入期间的多次修改只会触发一次写入。
+func (c *AdapterFile) markDirty() error {
+	if c.flushDelay <= 0 {
+		return c.Flush()
+	}
+	if c.dirty.Cas(false, true) {
+		gtimer.AddOnce(context.Background(), c.flushDelay, func(ctx context.Context) {
+			if c.dirty.Val() {
+				_ = c.Flush()
+			}
+		})
+	}
+	return nil
+}
+
+// load 从文件中加载未过期的缓存项，文件不存在时不做任何操作。
+func (c *AdapterFile) load() error {
+	content, err := os.ReadFile(c.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return gerror.Wrapf(err, `os.ReadFile failed for path "%s"`, c.path)
+	}
+	if len(content) == 0 {
+		return nil
+	}
+	var fileData []fileCacheItem
+	if err = json.UnmarshalUseNumber(content, &fileData); err != nil {
+		return gerror.WrapCodef(gcode.CodeInvalidConfiguration, err, `invalid cache file "%s"`, c.path)
+	}
+	var (
+		ctx      = context.Background()
+		nowMilli = gtime.TimestampMilli()
+	)
+	for _, item := range fileData {
+		var duration time.Duration
+		if item.E != 0 {
+			if item.E <= nowMilli {
+				continue
+			}
+			duration = time.Duration(item.E-nowMilli) * time.Millisecond
+		}
+		var value interface{}
+		if err = json.UnmarshalUseNumber(item.V, &value); err != nil {
+			return gerror.WrapCodef(gcode.CodeInvalidConfiguration, err, `invalid value of key "%s" in cache file "%s"`, item.K, c.path)
+		}
+		if err = c.AdapterMemory.Set(ctx, item.K, jsonNumberValue(value), duration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkFileValue 检查 `value` 是否可以使用 json 序列化。
+func checkFileValue(key interface{}, value interface{}) error {
+	if _, err := json.Marshal(value); err != nil {
+		return gerror.WrapCodef(gcode.CodeInvalidParameter, err, `value of key "%v" cannot be serialized to json`, key)
+	}
+	return nil
+}
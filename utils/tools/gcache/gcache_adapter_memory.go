@@ -8,7 +8,7 @@ import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtype"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
-	"math"
+	"sync"
 	"time"
 )
 
@@ -20,6 +20,14 @@ type AdapterMemory struct {
 	lru         *memoryLru         // lru 是 LRU 管理器，当属性 cap > 0 时启用。
 	eventList   *glist.List        // eventList 是用于内部数据同步的异步事件列表。
 	closed      *gtype.Bool        // closed 控制缓存是否关闭。
+
+	cleanupMu        sync.Mutex    // cleanupMu 确保清理定时器的替换是并发安全的。
+	cleanupEntry     *gtimer.Entry // cleanupEntry 是当前注册的清理定时任务。
+	cleanupInterval  *gtype.Int64  // cleanupInterval 是清理过期数据的定时间隔（纳秒）。
+	bucketSpan       *gtype.Int64  // bucketSpan 是过期桶的时间跨度（毫秒），过期时间落在同一跨度内的键归入同一个桶。
+	syncMu           sync.Mutex    // syncMu 确保同一时刻只有一个同步清理任务在执行。
+	syncedBucketSpan int64         // syncedBucketSpan 是 expireSets 中现有桶所使用的时间跨度，仅在 syncMu 内访问。
+	clearedBucket    int64         // clearedBucket 是上一次清理到的过期桶，仅在 syncMu 内访问。
 }
 
 // 内部事件项。
@@ -32,6 +40,15 @@ const (
 	// defaultMaxExpire 是无过期项的默认过期时间。
 	// 它等于 math.MaxInt64/1000000。
 	defaultMaxExpire = 9223372036854
+
+	// defaultCleanupInterval 是清理过期数据的默认定时间隔。
+	defaultCleanupInterval = time.Second
+
+	// defaultBucketSpan 是过期桶的默认时间跨度（毫秒）。
+	defaultBucketSpan = 1000
+
+	// minExpireBucketsToScan 是每次清理时至少检查的最近过期桶的数量。
+	minExpireBucketsToScan = 5
 )
 
 // NewAdapterMemory 创建并返回一个新的内存适配器缓存对象。
@@ -54,13 +71,52 @@ func doNewAdapterMemory() *AdapterMemory {
 		expireSets:  newMemoryExpireSets(),
 		eventList:   glist.New(true),
 		closed:      gtype.NewBool(),
+
+		cleanupInterval:  gtype.NewInt64(int64(defaultCleanupInterval)),
+		bucketSpan:       gtype.NewInt64(defaultBucketSpan),
+		syncedBucketSpan: defaultBucketSpan,
 	}
 	// 这里如果手动从内存适配器切换适配器，可能会有"定时器泄漏"。
 	// 不用担心这个问题，因为适配器很少更改，如果不使用它也不会做任何事情。
-	gtimer.AddSingleton(context.Background(), time.Second, c.syncEventAndClearExpired)
+	c.cleanupEntry = gtimer.AddSingleton(context.Background(), defaultCleanupInterval, c.syncEventAndClearExpired)
 	return c
 }
 
+// SetCleanupInterval 设置清理过期数据的定时间隔，默认为 1 秒，`interval` <= 0 时恢复默认值。
+// 较短的间隔可以更及时地回收大量短过期时间的键占用的内存，但会增加定时任务的开销。
+//
+// 修改间隔会关闭原有的清理定时器并注册新的定时器，不会造成定时器泄漏；缓存关闭后调用不做任何操作。
+func (c *AdapterMemory) SetCleanupInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultCleanupInterval
+	}
+	c.cleanupMu.Lock()
+	defer c.cleanupMu.Unlock()
+	if c.closed.Val() || time.Duration(c.cleanupInterval.Val()) == interval {
+		return
+	}
+	c.cleanupInterval.Set(int64(interval))
+	if c.cleanupEntry != nil {
+		c.cleanupEntry.Close()
+	}
+	c.cleanupEntry = gtimer.AddSingleton(context.Background(), interval, c.syncEventAndClearExpired)
+}
+
+// SetExpiryBucketSpan 设置过期桶的时间跨度，默认为 1 秒，最小为 1 毫秒，`span` <= 0 时恢复默认值。
+// 过期时间落在同一跨度内的键归入同一个桶并被一起清理，较小的跨度可以让过期键更快被回收。
+//
+// 已有的过期桶会在下一次清理任务中按新的跨度重新分组。
+func (c *AdapterMemory) SetExpiryBucketSpan(span time.Duration) {
+	spanMilli := span.Milliseconds()
+	switch {
+	case span <= 0:
+		spanMilli = defaultBucketSpan
+	case spanMilli < 1:
+		spanMilli = 1
+	}
+	c.bucketSpan.Set(spanMilli)
+}
+
 // Set 使用 `key`-`value` 对设置缓存，在 `duration` 时间后过期。
 //
 // 如果 `duration` == 0，则永不过期。
@@ -207,6 +263,8 @@ func (c *AdapterMemory) GetOrSet(ctx context.Context, key interface{}, value int
 //
 // 如果 `duration` == 0，则永不过期。
 // 如果 `duration` < 0 或者给定的 `value` 为 nil，则删除 `key`，但如果 `value` 是函数且函数结果为 nil，则不做任何操作。
+//
+// 如果 `ctx` 在调用 `f` 之前或写入缓存之前已被取消，则返回 `ctx` 的错误且不写入缓存。
 func (c *AdapterMemory) GetOrSetFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
 	defer c.handleLruKey(ctx, key)
 	v, err := c.Get(ctx, key)
@@ -214,6 +272,10 @@ func (c *AdapterMemory) GetOrSetFunc(ctx context.Context, key interface{}, f Fun
 		return nil, err
 	}
 	if v == nil {
+		// 上下文已取消时不再执行计算。
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
 		value, err := f(ctx)
 		if err != nil {
 			return nil, err
@@ -221,6 +283,10 @@ func (c *AdapterMemory) GetOrSetFunc(ctx context.Context, key interface{}, f Fun
 		if value == nil {
 			return nil, nil
 		}
+		// 计算期间上下文被取消时不写入缓存，避免写入过期的值。
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
 		return c.doSetWithLockCheck(ctx, key, value, duration)
 	}
 	return v, nil
@@ -232,6 +298,8 @@ func (c *AdapterMemory) GetOrSetFunc(ctx context.Context, key interface{}, f Fun
 // 如果 `duration` == 0，则永不过期。
 // 如果 `duration` < 0 或者给定的 `value` 为 nil，则删除 `key`，但如果 `value` 是函数且函数结果为 nil，则不做任何操作。
 //
+// 如果 `ctx` 在调用 `f` 之前或写入缓存之前已被取消，则返回 `ctx` 的错误且不写入缓存。
+//
 // 注意：与函数 `GetOrSetFunc` 的不同之处在于，函数 `f` 在写锁内执行，以保证并发安全。
 func (c *AdapterMemory) GetOrSetFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
 	defer c.handleLruKey(ctx, key)
@@ -322,6 +390,31 @@ func (c *AdapterMemory) UpdateExpire(ctx context.Context, key interface{}, durat
 	return
 }
 
+// Increment 原子地将 `key` 的整数值增加 `delta`，并返回新值。
+// 如果 `key` 不存在于缓存中，则视为 0，新键永不过期；如果 `key` 已存在，则保留其过期时间。
+// 如果现有值不是整数类型，则返回错误。
+func (c *AdapterMemory) Increment(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	expireTime := c.getInternalExpire(0)
+	value, created, err := c.data.Increment(key, delta, expireTime)
+	if err != nil {
+		return 0, err
+	}
+	if created {
+		c.eventList.PushBack(&adapterMemoryEvent{
+			k: key,
+			e: expireTime,
+		})
+	}
+	c.handleLruKey(ctx, key)
+	return value, nil
+}
+
+// Decrement 原子地将 `key` 的整数值减少 `delta`，并返回新值。
+// 也请参阅 Increment。
+func (c *AdapterMemory) Decrement(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	return c.Increment(ctx, key, -delta)
+}
+
 // Size 返回缓存的大小。
 func (c *AdapterMemory) Size(ctx context.Context) (size int, err error) {
 	return c.data.Size()
@@ -337,6 +430,13 @@ func (c *AdapterMemory) Keys(ctx context.Context) ([]interface{}, error) {
 	return c.data.Keys()
 }
 
+// KeysByPattern 返回缓存中字符串形式（gconv.String）匹配通配符 `pattern` 的所有未过期键，
+// 支持 `*` 和 `?` 等 path.Match 风格的通配符，例如 "user:*"。
+// 注意：此操作需要遍历全部缓存数据，缓存较大时开销较高，不建议在热点路径中调用。
+func (c *AdapterMemory) KeysByPattern(ctx context.Context, pattern string) ([]interface{}, error) {
+	return c.data.KeysByPattern(pattern)
+}
+
 // Values 以切片形式返回缓存中的所有值。
 func (c *AdapterMemory) Values(ctx context.Context) ([]interface{}, error) {
 	return c.data.Values()
@@ -350,9 +450,32 @@ func (c *AdapterMemory) Clear(ctx context.Context) error {
 	return nil
 }
 
+// ClearByPrefix 删除缓存中所有字符串形式以 `prefix` 开头的键，并返回删除的数量。
+// 适用于多租户等场景下按前缀失效部分缓存。
+func (c *AdapterMemory) ClearByPrefix(ctx context.Context, prefix string) (removed int, err error) {
+	removedKeys := c.data.RemoveByPrefix(prefix)
+	if len(removedKeys) == 0 {
+		return 0, nil
+	}
+	c.lru.Remove(removedKeys...)
+	for _, key := range removedKeys {
+		c.eventList.PushBack(&adapterMemoryEvent{
+			k: key,
+			e: gtime.TimestampMilli() - 1000,
+		})
+	}
+	return len(removedKeys), nil
+}
+
 // Close 关闭缓存。
 func (c *AdapterMemory) Close(ctx context.Context) error {
+	c.cleanupMu.Lock()
+	defer c.cleanupMu.Unlock()
 	c.closed.Set(true)
+	if c.cleanupEntry != nil {
+		c.cleanupEntry.Close()
+		c.cleanupEntry = nil
+	}
 	return nil
 }
 
@@ -378,9 +501,33 @@ func (c *AdapterMemory) getInternalExpire(duration time.Duration) int64 {
 	return gtime.TimestampMilli() + duration.Nanoseconds()/1000000
 }
 
-// makeExpireKey 将 `expire`（毫秒）分组到其对应的秒。
-func (c *AdapterMemory) makeExpireKey(expire int64) int64 {
-	return int64(math.Ceil(float64(expire/1000)+1) * 1000)
+// makeExpireKey 将 `expire`（毫秒）分组到时间跨度为 `span`（毫秒）的过期桶。
+func (c *AdapterMemory) makeExpireKey(expire, span int64) int64 {
+	return (expire/span + 1) * span
+}
+
+// rebuildExpireSets 在过期桶的时间跨度变更后，将 expireSets 中的所有键按新的跨度 `span` 重新分组。
+// 已经过期的键直接删除，避免它们落入清理任务不再扫描的旧桶中。
+func (c *AdapterMemory) rebuildExpireSets(span int64) {
+	nowMilli := gtime.TimestampMilli()
+	for _, set := range c.expireSets.Drain() {
+		set.Iterator(func(key interface{}) bool {
+			item, ok := c.data.Get(key)
+			if !ok {
+				c.expireTimes.Delete(key)
+				return true
+			}
+			if item.e <= nowMilli {
+				c.deleteExpiredKey(key)
+				c.lru.Remove(key)
+				return true
+			}
+			expireKey := c.makeExpireKey(item.e, span)
+			c.expireSets.GetOrNew(expireKey).Add(key)
+			c.expireTimes.Set(key, expireKey)
+			return true
+		})
+	}
 }
 
 // syncEventAndClearExpired 执行异步任务循环：
@@ -391,11 +538,20 @@ func (c *AdapterMemory) syncEventAndClearExpired(ctx context.Context) {
 		gtimer.Exit()
 		return
 	}
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
 	var (
 		event         *adapterMemoryEvent
 		oldExpireTime int64
 		newExpireTime int64
+		minExpireKey  int64
+		span          = c.bucketSpan.Val()
 	)
+	if span != c.syncedBucketSpan {
+		c.rebuildExpireSets(span)
+		c.syncedBucketSpan = span
+		c.clearedBucket = 0
+	}
 	// ================================
 	// 数据过期同步。
 	// ================================
@@ -408,9 +564,12 @@ func (c *AdapterMemory) syncEventAndClearExpired(ctx context.Context) {
 		// 获取旧的过期集合。
 		oldExpireTime = c.expireTimes.Get(event.k)
 		// 计算新的过期时间集合。
-		newExpireTime = c.makeExpireKey(event.e)
+		newExpireTime = c.makeExpireKey(event.e, span)
 		// 此键的过期时间已更改。
 		if newExpireTime != oldExpireTime {
+			if minExpireKey == 0 || newExpireTime < minExpireKey {
+				minExpireKey = newExpireTime
+			}
 			c.expireSets.GetOrNew(newExpireTime).Add(event.k)
 			if oldExpireTime != 0 {
 				c.expireSets.GetOrNew(oldExpireTime).Remove(event.k)
@@ -425,11 +584,25 @@ func (c *AdapterMemory) syncEventAndClearExpired(ctx context.Context) {
 	var (
 		expireSet  *gset.Set
 		expireTime int64
-		currentEk  = c.makeExpireKey(gtime.TimestampMilli())
+		currentEk  = c.makeExpireKey(gtime.TimestampMilli(), span)
+		// 至少覆盖一个清理间隔内的所有桶，并额外检查几个桶以容忍定时任务的延迟。
+		scanCount = c.cleanupInterval.Val()/int64(time.Millisecond)/span + minExpireBucketsToScan - 1
 	)
-	// 自动移除最近几秒的过期键集合。
-	for i := int64(1); i <= 5; i++ {
-		expireTime = currentEk - i*1000
+	if scanCount < minExpireBucketsToScan {
+		scanCount = minExpireBucketsToScan
+	}
+	// 定时任务的实际执行间隔可能远大于设置的间隔（如小于定时器精度或任务被延迟），
+	// 因此从上一次清理到的桶以及本次新加入的最早的桶开始扫描，确保不会遗漏任何过期桶。
+	startEk := currentEk - scanCount*span
+	if c.clearedBucket != 0 && c.clearedBucket+span < startEk {
+		startEk = c.clearedBucket + span
+	}
+	if minExpireKey != 0 && minExpireKey < startEk {
+		startEk = minExpireKey
+	}
+	c.clearedBucket = currentEk - span
+	// 自动移除最近的过期键集合。
+	for expireTime = startEk; expireTime < currentEk; expireTime += span {
 		if expireSet = c.expireSets.Get(expireTime); expireSet != nil {
 			// 遍历集合以删除其中的所有键。
 			expireSet.Iterator(func(key interface{}) bool {
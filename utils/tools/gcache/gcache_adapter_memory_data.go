@@ -2,7 +2,12 @@ package gcache
 
 import (
 	"context"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtime"
+	"path"
+	"strings"
 	"sync"
 	"time"
 )
@@ -76,6 +81,23 @@ func (d *memoryData) Remove(keys ...interface{}) (removedKeys []interface{}, val
 	return removedKeys, value, nil
 }
 
+// RemoveByPrefix 删除缓存中所有字符串形式以 `prefix` 开头的键，并返回被删除的键。
+// 整个过程只持有一次写锁，先收集匹配的键再统一删除。
+func (d *memoryData) RemoveByPrefix(prefix string) (removedKeys []interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	removedKeys = make([]interface{}, 0)
+	for key := range d.data {
+		if strings.HasPrefix(gconv.String(key), prefix) {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	for _, key := range removedKeys {
+		delete(d.data, key)
+	}
+	return removedKeys
+}
+
 // Data 返回缓存中所有键值对的副本，作为 map 类型。
 func (d *memoryData) Data() (map[interface{}]interface{}, error) {
 	d.mu.RLock()
@@ -92,6 +114,22 @@ func (d *memoryData) Data() (map[interface{}]interface{}, error) {
 	return data, nil
 }
 
+// Items 返回缓存中所有未过期项的副本。
+func (d *memoryData) Items() map[interface{}]memoryDataItem {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var (
+		items    = make(map[interface{}]memoryDataItem, len(d.data))
+		nowMilli = gtime.TimestampMilli()
+	)
+	for k, v := range d.data {
+		if v.e > nowMilli {
+			items[k] = v
+		}
+	}
+	return items
+}
+
 // Keys 返回缓存中所有键的副本，作为 slice 类型。
 func (d *memoryData) Keys() ([]interface{}, error) {
 	d.mu.RLock()
@@ -108,6 +146,35 @@ func (d *memoryData) Keys() ([]interface{}, error) {
 	return keys, nil
 }
 
+// KeysByPattern 返回缓存中字符串形式匹配通配符 `pattern` 的未过期键，匹配规则与 path.Match 相同。
+func (d *memoryData) KeysByPattern(pattern string) ([]interface{}, error) {
+	if err := checkKeyPattern(pattern); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var (
+		keys     = make([]interface{}, 0)
+		nowMilli = gtime.TimestampMilli()
+	)
+	for k, v := range d.data {
+		if v.e > nowMilli {
+			if matched, _ := path.Match(pattern, gconv.String(k)); matched {
+				keys = append(keys, k)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// checkKeyPattern 检查通配符 `pattern` 是否合法。
+func checkKeyPattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid key pattern "%s"`, pattern)
+	}
+	return nil
+}
+
 // Values 返回缓存中所有值的副本，作为 slice 类型。
 func (d *memoryData) Values() ([]interface{}, error) {
 	d.mu.RLock()
@@ -189,17 +256,69 @@ func (d *memoryData) SetWithLock(ctx context.Context, key interface{}, value int
 		f, ok = value.(func(ctx context.Context) (value interface{}, err error))
 	}
 	if ok {
+		// 上下文已取消时不再执行计算。
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
 		if value, err = f(ctx); err != nil {
 			return nil, err
 		}
 		if value == nil {
 			return nil, nil
 		}
+		// 计算期间上下文被取消时不写入缓存。
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
 	}
 	d.data[key] = memoryDataItem{v: value, e: expireTimestamp}
 	return value, nil
 }
 
+// Increment 在写锁下将 `key` 的整数值增加 `delta`，并返回新值。
+// 如果 `key` 不存在或已过期，则视为 0 并使用 `expireTimestamp` 作为过期时间，返回的 `created` 为 true；
+// 否则保留其原有的过期时间。如果现有值不是整数类型，则返回错误。
+func (d *memoryData) Increment(key interface{}, delta int64, expireTimestamp int64) (value int64, created bool, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	item, ok := d.data[key]
+	if !ok || item.IsExpired() {
+		d.data[key] = memoryDataItem{v: delta, e: expireTimestamp}
+		return delta, true, nil
+	}
+	switch v := item.v.(type) {
+	case int:
+		value = int64(v)
+	case int8:
+		value = int64(v)
+	case int16:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case int64:
+		value = v
+	case uint:
+		value = int64(v)
+	case uint8:
+		value = int64(v)
+	case uint16:
+		value = int64(v)
+	case uint32:
+		value = int64(v)
+	case uint64:
+		value = int64(v)
+	default:
+		return 0, false, gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`cannot increment non-integer value of type "%T" for key "%v"`,
+			item.v, key,
+		)
+	}
+	value += delta
+	d.data[key] = memoryDataItem{v: value, e: item.e}
+	return value, false, nil
+}
+
 func (d *memoryData) Delete(key interface{}) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -45,3 +45,12 @@ func (d *memoryExpireSets) Delete(key int64) {
 	delete(d.expireSets, key)
 	d.mu.Unlock()
 }
+
+// Drain 清空并返回所有的过期桶。
+func (d *memoryExpireSets) Drain() map[int64]*gset.Set {
+	d.mu.Lock()
+	sets := d.expireSets
+	d.expireSets = make(map[int64]*gset.Set)
+	d.mu.Unlock()
+	return sets
+}
@@ -0,0 +1,134 @@
+package gcache
+
+import (
+	"context"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtime"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
+	"time"
+)
+
+// memorySnapshot 是 AdapterMemory.Export 导出的缓存快照。
+type memorySnapshot struct {
+	ExportedAt int64                `json:"exportedAt"` // ExportedAt 是导出时的时间戳（毫秒）。
+	Items      []memorySnapshotItem `json:"items"`      // Items 是导出的缓存项。
+}
+
+// memorySnapshotItem 是缓存快照中的一个缓存项。
+type memorySnapshotItem struct {
+	Key   string          `json:"key"`   // Key 是缓存键。
+	Value json.RawMessage `json:"value"` // Value 是 JSON 编码后的缓存值。
+	TTL   int64           `json:"ttl"`   // TTL 是导出时剩余的过期时间（毫秒），0 表示永不过期。
+}
+
+// Export 将缓存中所有未过期的项及其剩余过期时间导出为 JSON 快照，可通过 Import 恢复到其他实例，
+// 例如在实例之间迁移已预热的缓存。
+//
+// 只导出字符串类型的键，以及可以 JSON 序列化的值，其他项会被跳过，跳过的数量通过 `skipped` 返回。
+// 注意值在导入后为 JSON 解码的结果，如结构体会变为 map，可通过返回的 *gvar.Var 进行转换；
+// 整数值导入后为 int64，可以继续使用 Increment，其他数值导入后为 float64（没有小数部分的浮点数如 2.0 会被视为整数）。
+func (c *AdapterMemory) Export(ctx context.Context) (data []byte, skipped int, err error) {
+	var (
+		items    = c.data.Items()
+		nowMilli = gtime.TimestampMilli()
+		snapshot = memorySnapshot{
+			ExportedAt: nowMilli,
+			Items:      make([]memorySnapshotItem, 0, len(items)),
+		}
+	)
+	for k, item := range items {
+		key, ok := k.(string)
+		if !ok {
+			skipped++
+			continue
+		}
+		value, err := json.Marshal(item.v)
+		if err != nil {
+			skipped++
+			continue
+		}
+		var ttl int64
+		if item.e < defaultMaxExpire {
+			// 读取数据与获取当前时间之间过期的项需要跳过，否则 TTL 为 0 会在导入时被当作永不过期。
+			if ttl = item.e - nowMilli; ttl <= 0 {
+				continue
+			}
+		}
+		snapshot.Items = append(snapshot.Items, memorySnapshotItem{
+			Key:   key,
+			Value: value,
+			TTL:   ttl,
+		})
+	}
+	if data, err = json.Marshal(snapshot); err != nil {
+		return nil, skipped, err
+	}
+	return data, skipped, nil
+}
+
+// Import 从 Export 导出的 JSON 快照 `data` 中恢复缓存项，并返回导入的数量。
+// 剩余过期时间会扣除从导出到导入经过的时间，已过期的项会被跳过。
+// 如果 `overwrite` 为 true，则覆盖已存在的键，否则保留已存在的键。
+func (c *AdapterMemory) Import(ctx context.Context, data []byte, overwrite bool) (imported int, err error) {
+	var snapshot memorySnapshot
+	if err = json.Unmarshal(data, &snapshot); err != nil {
+		return 0, gerror.WrapCode(gcode.CodeInvalidParameter, err, `invalid cache snapshot`)
+	}
+	elapsed := gtime.TimestampMilli() - snapshot.ExportedAt
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	for _, item := range snapshot.Items {
+		var duration time.Duration
+		if item.TTL > 0 {
+			remaining := item.TTL - elapsed
+			if remaining <= 0 {
+				continue
+			}
+			duration = time.Duration(remaining) * time.Millisecond
+		}
+		var value interface{}
+		if err = json.UnmarshalUseNumber(item.Value, &value); err != nil {
+			return imported, gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid value of key "%s" in cache snapshot`, item.Key)
+		}
+		if value == nil {
+			continue
+		}
+		value = jsonNumberValue(value)
+		if overwrite {
+			if err = c.Set(ctx, item.Key, value, duration); err != nil {
+				return imported, err
+			}
+		} else {
+			ok, err := c.SetIfNotExist(ctx, item.Key, value, duration)
+			if err != nil {
+				return imported, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		imported++
+	}
+	return imported, nil
+}
+
+// jsonNumberValue 将使用 UseNumber 解码得到的数值转换为 Go 数值类型：整数转换为 int64，以便恢复后仍可以使用 Increment；
+// 其他数值转换为 float64，与浮点数导出前的类型一致。非数值原样返回。
+func jsonNumberValue(value interface{}) interface{} {
+	number, ok := value.(interface {
+		Int64() (int64, error)
+		Float64() (float64, error)
+	})
+	if !ok {
+		return value
+	}
+	if i, err := number.Int64(); err == nil {
+		return i
+	}
+	if f, err := number.Float64(); err == nil {
+		return f
+	}
+	return value
+}
@@ -0,0 +1,191 @@
+package gcache
+
+import (
+	"context"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
+	"strings"
+	"time"
+)
+
+// adapterPrefix 是为所有键自动添加前缀的适配器，用于实现带命名空间的缓存视图。
+// 它本身不存储数据，所有操作都委托给底层适配器。
+type adapterPrefix struct {
+	adapter Adapter // adapter 是底层适配器。
+	prefix  string  // prefix 是添加到每个键前的前缀。
+}
+
+// newAdapterPrefix 创建并返回一个为键添加 `prefix` 前缀的适配器。
+func newAdapterPrefix(adapter Adapter, prefix string) *adapterPrefix {
+	return &adapterPrefix{
+		adapter: adapter,
+		prefix:  prefix,
+	}
+}
+
+// Set 使用 `key`-`value` 对设置缓存，在 `duration` 时间后过期。
+func (a *adapterPrefix) Set(ctx context.Context, key interface{}, value interface{}, duration time.Duration) error {
+	return a.adapter.Set(ctx, a.key(key), value, duration)
+}
+
+// SetMap 批量设置缓存，使用 `data` 映射中的键值对，在 `duration` 时间后过期。
+func (a *adapterPrefix) SetMap(ctx context.Context, data map[interface{}]interface{}, duration time.Duration) error {
+	prefixed := make(map[interface{}]interface{}, len(data))
+	for k, v := range data {
+		prefixed[a.key(k)] = v
+	}
+	return a.adapter.SetMap(ctx, prefixed, duration)
+}
+
+// SetIfNotExist 仅在 `key` 不存在于缓存中时，使用 `key`-`value` 对设置缓存。
+func (a *adapterPrefix) SetIfNotExist(ctx context.Context, key interface{}, value interface{}, duration time.Duration) (bool, error) {
+	return a.adapter.SetIfNotExist(ctx, a.key(key), value, duration)
+}
+
+// SetIfNotExistFunc 仅在 `key` 不存在于缓存中时，使用函数 `f` 的结果设置 `key`。
+func (a *adapterPrefix) SetIfNotExistFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (bool, error) {
+	return a.adapter.SetIfNotExistFunc(ctx, a.key(key), f, duration)
+}
+
+// SetIfNotExistFuncLock 仅在 `key` 不存在于缓存中时，在写锁内使用函数 `f` 的结果设置 `key`。
+func (a *adapterPrefix) SetIfNotExistFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (bool, error) {
+	return a.adapter.SetIfNotExistFuncLock(ctx, a.key(key), f, duration)
+}
+
+// Get 检索并返回给定 `key` 的关联值。
+func (a *adapterPrefix) Get(ctx context.Context, key interface{}) (*gvar.Var, error) {
+	return a.adapter.Get(ctx, a.key(key))
+}
+
+// GetOrSet 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则设置 `key`-`value` 对并返回 `value`。
+func (a *adapterPrefix) GetOrSet(ctx context.Context, key interface{}, value interface{}, duration time.Duration) (*gvar.Var, error) {
+	return a.adapter.GetOrSet(ctx, a.key(key), value, duration)
+}
+
+// GetOrSetFunc 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则使用函数 `f` 的结果设置 `key`。
+func (a *adapterPrefix) GetOrSetFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
+	return a.adapter.GetOrSetFunc(ctx, a.key(key), f, duration)
+}
+
+// GetOrSetFuncLock 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则在写锁内使用函数 `f` 的结果设置 `key`。
+func (a *adapterPrefix) GetOrSetFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
+	return a.adapter.GetOrSetFuncLock(ctx, a.key(key), f, duration)
+}
+
+// Contains 检查并返回 true 如果 `key` 存在于缓存中，否则返回 false。
+func (a *adapterPrefix) Contains(ctx context.Context, key interface{}) (bool, error) {
+	return a.adapter.Contains(ctx, a.key(key))
+}
+
+// Size 返回当前前缀下的项目数量。
+func (a *adapterPrefix) Size(ctx context.Context) (int, error) {
+	keys, err := a.Keys(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// Data 以映射类型返回当前前缀下所有键值对的副本，返回的键不包含前缀。
+func (a *adapterPrefix) Data(ctx context.Context) (map[interface{}]interface{}, error) {
+	data, err := a.adapter.Data(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[interface{}]interface{})
+	for k, v := range data {
+		if key, ok := a.trim(k); ok {
+			result[key] = v
+		}
+	}
+	return result, nil
+}
+
+// Keys 以切片形式返回当前前缀下的所有键，返回的键不包含前缀。
+func (a *adapterPrefix) Keys(ctx context.Context) ([]interface{}, error) {
+	keys, err := a.adapter.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]interface{}, 0)
+	for _, k := range keys {
+		if key, ok := a.trim(k); ok {
+			result = append(result, key)
+		}
+	}
+	return result, nil
+}
+
+// Values 以切片形式返回当前前缀下的所有值。
+func (a *adapterPrefix) Values(ctx context.Context) ([]interface{}, error) {
+	data, err := a.Data(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, 0, len(data))
+	for _, v := range data {
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// Update 更新 `key` 的值而不改变其过期时间，并返回旧值。
+func (a *adapterPrefix) Update(ctx context.Context, key interface{}, value interface{}) (*gvar.Var, bool, error) {
+	return a.adapter.Update(ctx, a.key(key), value)
+}
+
+// Increment 原子地将 `key` 的整数值增加 `delta`，并返回新值，需要底层适配器支持。
+func (a *adapterPrefix) Increment(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	return NewWithAdapter(a.adapter).Increment(ctx, a.key(key), delta)
+}
+
+// UpdateExpire 更新 `key` 的过期时间，并返回旧的过期时间值。
+func (a *adapterPrefix) UpdateExpire(ctx context.Context, key interface{}, duration time.Duration) (time.Duration, error) {
+	return a.adapter.UpdateExpire(ctx, a.key(key), duration)
+}
+
+// GetExpire 检索并返回缓存中 `key` 的过期时间。
+func (a *adapterPrefix) GetExpire(ctx context.Context, key interface{}) (time.Duration, error) {
+	return a.adapter.GetExpire(ctx, a.key(key))
+}
+
+// Remove 从缓存中删除一个或多个键，并返回其值。
+func (a *adapterPrefix) Remove(ctx context.Context, keys ...interface{}) (*gvar.Var, error) {
+	prefixed := make([]interface{}, len(keys))
+	for i, k := range keys {
+		prefixed[i] = a.key(k)
+	}
+	return a.adapter.Remove(ctx, prefixed...)
+}
+
+// Clear 只清除当前前缀下的数据，不影响其他前缀或底层适配器中的其他数据。
+func (a *adapterPrefix) Clear(ctx context.Context) error {
+	keys, err := a.Keys(ctx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err = a.Remove(ctx, keys...)
+	return err
+}
+
+// Close 不做任何操作，底层适配器由其所有者负责关闭。
+func (a *adapterPrefix) Close(ctx context.Context) error {
+	return nil
+}
+
+// key 返回添加前缀后的键。
+func (a *adapterPrefix) key(key interface{}) string {
+	return a.prefix + gconv.String(key)
+}
+
+// trim 去除键 `key` 的前缀，如果 `key` 不属于当前前缀则返回 false。
+func (a *adapterPrefix) trim(key interface{}) (string, bool) {
+	s := gconv.String(key)
+	if !strings.HasPrefix(s, a.prefix) {
+		return "", false
+	}
+	return s[len(a.prefix):], true
+}
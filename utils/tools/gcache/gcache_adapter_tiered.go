@@ -0,0 +1,233 @@
+package gcache
+
+import (
+	"context"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
+	"time"
+)
+
+// AdapterTiered 是一个二级缓存适配器，通常由本地内存缓存（L1）和共享缓存（如 Redis，L2）组成，用于降低读取延迟。
+//
+// 读取时优先读取 L1，未命中时读取 L2，并将 L2 的结果以不超过 l1TTL 的过期时间写入 L1；
+// 写入时同时写入 L2 和 L1（写穿透），删除和清空时同时作用于两级缓存。
+// L2 是权威数据源，Size、Data、Keys、Values 和 GetExpire 等操作只读取 L2。
+//
+// 注意：多个实例共享同一个 L2 时，一个实例的写入或删除不会使其他实例的 L1 失效，
+// 其他实例可能在 l1TTL 时间内读取到旧值，因此 l1TTL 应设置为可以接受的数据不一致时间。
+type AdapterTiered struct {
+	l1    Adapter       // l1 是一级缓存，通常为本地内存缓存。
+	l2    Adapter       // l2 是二级缓存，通常为共享缓存，是权威数据源。
+	l1TTL time.Duration // l1TTL 是写入 L1 的数据的最大过期时间，<= 0 时与写入 L2 的过期时间相同。
+}
+
+// NewAdapterTiered 使用一级缓存 `l1` 和二级缓存 `l2` 创建并返回一个二级缓存适配器。
+// 写入 L1 的数据的过期时间不超过 `l1TTL`，`l1TTL` <= 0 时不做限制。
+func NewAdapterTiered(l1, l2 Adapter, l1TTL time.Duration) *AdapterTiered {
+	return &AdapterTiered{
+		l1:    l1,
+		l2:    l2,
+		l1TTL: l1TTL,
+	}
+}
+
+// l1Duration 返回写入 L1 时使用的过期时间，即 `duration` 与 l1TTL 中较小的一个。
+// `duration` == 0 表示永不过期，`duration` < 0 表示删除，原样返回。
+func (c *AdapterTiered) l1Duration(duration time.Duration) time.Duration {
+	if c.l1TTL <= 0 || duration < 0 {
+		return duration
+	}
+	if duration == 0 || duration > c.l1TTL {
+		return c.l1TTL
+	}
+	return duration
+}
+
+// populate 将从 L2 读取到的值以受限的过期时间写入 L1。
+func (c *AdapterTiered) populate(ctx context.Context, key interface{}, value *gvar.Var, duration time.Duration) error {
+	if value == nil {
+		return nil
+	}
+	return c.l1.Set(ctx, key, value.Val(), c.l1Duration(duration))
+}
+
+// Set 使用 `key`-`value` 对同时设置两级缓存，L1 的过期时间不超过 l1TTL。
+func (c *AdapterTiered) Set(ctx context.Context, key interface{}, value interface{}, duration time.Duration) error {
+	if err := c.l2.Set(ctx, key, value, duration); err != nil {
+		return err
+	}
+	return c.l1.Set(ctx, key, value, c.l1Duration(duration))
+}
+
+// SetMap 使用 `data` 映射中的键值对同时批量设置两级缓存。
+func (c *AdapterTiered) SetMap(ctx context.Context, data map[interface{}]interface{}, duration time.Duration) error {
+	if err := c.l2.SetMap(ctx, data, duration); err != nil {
+		return err
+	}
+	return c.l1.SetMap(ctx, data, c.l1Duration(duration))
+}
+
+// SetIfNotExist 仅在 `key` 不存在于 L2 中时设置缓存，设置成功后使 L1 中的 `key` 失效。
+func (c *AdapterTiered) SetIfNotExist(ctx context.Context, key interface{}, value interface{}, duration time.Duration) (bool, error) {
+	ok, err := c.l2.SetIfNotExist(ctx, key, value, duration)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return ok, c.invalidate(ctx, key)
+}
+
+// SetIfNotExistFunc 仅在 `key` 不存在于 L2 中时，使用函数 `f` 的结果设置缓存。
+func (c *AdapterTiered) SetIfNotExistFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (bool, error) {
+	ok, err := c.l2.SetIfNotExistFunc(ctx, key, f, duration)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return ok, c.invalidate(ctx, key)
+}
+
+// SetIfNotExistFuncLock 仅在 `key` 不存在于 L2 中时，在写锁内使用函数 `f` 的结果设置缓存。
+func (c *AdapterTiered) SetIfNotExistFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (bool, error) {
+	ok, err := c.l2.SetIfNotExistFuncLock(ctx, key, f, duration)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return ok, c.invalidate(ctx, key)
+}
+
+// Get 检索并返回给定 `key` 的关联值。
+// 优先读取 L1，未命中时读取 L2，并将结果以不超过 l1TTL 的过期时间写入 L1。
+func (c *AdapterTiered) Get(ctx context.Context, key interface{}) (*gvar.Var, error) {
+	v, err := c.l1.Get(ctx, key)
+	if err != nil || v != nil {
+		return v, err
+	}
+	if v, err = c.l2.Get(ctx, key); err != nil || v == nil {
+		return v, err
+	}
+	expire, err := c.l2.GetExpire(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if expire < 0 {
+		// 读取期间已过期或被删除。
+		return v, nil
+	}
+	return v, c.populate(ctx, key, v, expire)
+}
+
+// GetOrSet 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则设置 `key`-`value` 对并返回 `value`。
+func (c *AdapterTiered) GetOrSet(ctx context.Context, key interface{}, value interface{}, duration time.Duration) (*gvar.Var, error) {
+	v, err := c.Get(ctx, key)
+	if err != nil || v != nil {
+		return v, err
+	}
+	if v, err = c.l2.GetOrSet(ctx, key, value, duration); err != nil {
+		return nil, err
+	}
+	return v, c.populate(ctx, key, v, duration)
+}
+
+// GetOrSetFunc 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则使用函数 `f` 的结果设置 `key` 并返回其结果。
+func (c *AdapterTiered) GetOrSetFunc(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
+	v, err := c.Get(ctx, key)
+	if err != nil || v != nil {
+		return v, err
+	}
+	if v, err = c.l2.GetOrSetFunc(ctx, key, f, duration); err != nil {
+		return nil, err
+	}
+	return v, c.populate(ctx, key, v, duration)
+}
+
+// GetOrSetFuncLock 与 GetOrSetFunc 相同，但函数 `f` 在 L2 的写锁内执行。
+func (c *AdapterTiered) GetOrSetFuncLock(ctx context.Context, key interface{}, f Func, duration time.Duration) (*gvar.Var, error) {
+	v, err := c.Get(ctx, key)
+	if err != nil || v != nil {
+		return v, err
+	}
+	if v, err = c.l2.GetOrSetFuncLock(ctx, key, f, duration); err != nil {
+		return nil, err
+	}
+	return v, c.populate(ctx, key, v, duration)
+}
+
+// Contains 检查 `key` 是否存在于 L1 或 L2 中。
+func (c *AdapterTiered) Contains(ctx context.Context, key interface{}) (bool, error) {
+	ok, err := c.l1.Contains(ctx, key)
+	if err != nil || ok {
+		return ok, err
+	}
+	return c.l2.Contains(ctx, key)
+}
+
+// Size 返回 L2 中的项数。
+func (c *AdapterTiered) Size(ctx context.Context) (int, error) {
+	return c.l2.Size(ctx)
+}
+
+// Data 返回 L2 中所有键值对的副本。
+func (c *AdapterTiered) Data(ctx context.Context) (map[interface{}]interface{}, error) {
+	return c.l2.Data(ctx)
+}
+
+// Keys 返回 L2 中的所有键。
+func (c *AdapterTiered) Keys(ctx context.Context) ([]interface{}, error) {
+	return c.l2.Keys(ctx)
+}
+
+// Values 返回 L2 中的所有值。
+func (c *AdapterTiered) Values(ctx context.Context) ([]interface{}, error) {
+	return c.l2.Values(ctx)
+}
+
+// Update 更新 L2 中 `key` 的值而不改变其过期时间，并使 L1 中的 `key` 失效。
+func (c *AdapterTiered) Update(ctx context.Context, key interface{}, value interface{}) (oldValue *gvar.Var, exist bool, err error) {
+	if oldValue, exist, err = c.l2.Update(ctx, key, value); err != nil {
+		return
+	}
+	err = c.invalidate(ctx, key)
+	return
+}
+
+// UpdateExpire 更新 L2 中 `key` 的过期时间，并使 L1 中的 `key` 失效。
+func (c *AdapterTiered) UpdateExpire(ctx context.Context, key interface{}, duration time.Duration) (oldDuration time.Duration, err error) {
+	if oldDuration, err = c.l2.UpdateExpire(ctx, key, duration); err != nil {
+		return
+	}
+	err = c.invalidate(ctx, key)
+	return
+}
+
+// GetExpire 返回 L2 中 `key` 的过期时间。
+func (c *AdapterTiered) GetExpire(ctx context.Context, key interface{}) (time.Duration, error) {
+	return c.l2.GetExpire(ctx, key)
+}
+
+// Remove 从两级缓存中删除一个或多个键，并返回 L2 中最后一个被删除键的值。
+func (c *AdapterTiered) Remove(ctx context.Context, keys ...interface{}) (*gvar.Var, error) {
+	if _, err := c.l1.Remove(ctx, keys...); err != nil {
+		return nil, err
+	}
+	return c.l2.Remove(ctx, keys...)
+}
+
+// Clear 清空两级缓存。
+func (c *AdapterTiered) Clear(ctx context.Context) error {
+	if err := c.l1.Clear(ctx); err != nil {
+		return err
+	}
+	return c.l2.Clear(ctx)
+}
+
+// Close 关闭两级缓存。
+func (c *AdapterTiered) Close(ctx context.Context) error {
+	if err := c.l1.Close(ctx); err != nil {
+		return err
+	}
+	return c.l2.Close(ctx)
+}
+
+// invalidate 使 L1 中的 `key` 失效，下次读取时从 L2 重新加载。
+func (c *AdapterTiered) invalidate(ctx context.Context, key interface{}) error {
+	_, err := c.l1.Remove(ctx, key)
+	return err
+}
@@ -2,17 +2,36 @@ package gcache
 
 import (
 	"context"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/grand"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
+	"path"
+	"sync"
+	"time"
 )
 
 // Cache struct.
 type Cache struct {
 	localAdapter
+	missesMu sync.Mutex     // missesMu 确保负缓存存储只创建一次。
+	misses   *AdapterMemory // misses 是 GetOrSetFuncWithError 的负缓存存储，与用户可见的键空间隔离，首次使用时创建。
 }
 
 // localAdapter 是 Adapter 的别名，仅用于嵌入属性。
 type localAdapter = Adapter
 
+// iIncrement 是支持原子自增的适配器需要实现的接口。
+type iIncrement interface {
+	Increment(ctx context.Context, key interface{}, delta int64) (int64, error)
+}
+
+// iKeysByPattern 是支持按通配符检索键的适配器需要实现的接口。
+type iKeysByPattern interface {
+	KeysByPattern(ctx context.Context, pattern string) ([]interface{}, error)
+}
+
 // 新建时使用默认的内存适配器创建并返回新的缓存对象。
 // 注意，LRU功能仅通过内存适配器实现。
 func New(lruCap ...int) *Cache {
@@ -46,6 +65,140 @@ func (c *Cache) GetAdapter() Adapter {
 	return c.localAdapter
 }
 
+// GetOrSetFuncWithError 检索并返回 `key` 的值，如果 `key` 不存在于缓存中，则使用函数 `f` 的结果设置 `key`，
+// 键值对在 `duration` 时间后过期。
+//
+// 与 GetOrSetFunc 不同的是，当 `f` 返回 nil 值（且没有错误）时，会记录一个负缓存标记，
+// 标记在 `negativeTTL` 时间后过期。在标记有效期内再次调用时直接返回 nil，不会再次调用 `f`，
+// 以避免频繁请求数据源。`negativeTTL` <= 0 时不缓存负结果。
+// `f` 返回的错误不会被缓存。
+//
+// 负缓存标记保存在当前 Cache 对象独立的内存存储中，不会出现在 Get、Keys、Data 等操作的结果中，
+// 也不会在共享同一个适配器（如 Redis）的多个进程间共享。Remove 和 Clear 会同时删除对应的负缓存标记。
+func (c *Cache) GetOrSetFuncWithError(ctx context.Context, key interface{}, f Func, duration, negativeTTL time.Duration) (*gvar.Var, error) {
+	v, err := c.localAdapter.Get(ctx, key)
+	if err != nil || v != nil {
+		return v, err
+	}
+	if misses := c.getMisses(false); misses != nil {
+		if ok, _ := misses.Contains(ctx, key); ok {
+			return nil, nil
+		}
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	value, err := f(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	if value == nil {
+		if negativeTTL > 0 {
+			if err = c.getMisses(true).Set(ctx, key, true, negativeTTL); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	if err = c.localAdapter.Set(ctx, key, value, duration); err != nil {
+		return nil, err
+	}
+	return gvar.New(value), nil
+}
+
+// GetOrSetMany 批量检索并返回 `keys` 的值，对缓存中不存在的键只调用一次函数 `f` 计算，
+// 并将计算结果以 `duration` 过期时间写入缓存，适用于批量回源数据库等场景。
+// `f` 接收全部缺失的键，未在返回结果中出现（或值为 nil）的键视为不存在，不会出现在返回结果中。
+// 没有缺失的键时不会调用 `f`。
+func (c *Cache) GetOrSetMany(
+	ctx context.Context, keys []interface{},
+	f func(ctx context.Context, missing []interface{}) (map[interface{}]interface{}, error),
+	duration time.Duration,
+) (map[interface{}]*gvar.Var, error) {
+	var (
+		result  = make(map[interface{}]*gvar.Var, len(keys))
+		missing = make([]interface{}, 0)
+	)
+	for _, key := range keys {
+		if _, ok := result[key]; ok {
+			continue
+		}
+		v, err := c.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			result[key] = v
+			continue
+		}
+		missing = append(missing, key)
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	values, err := f(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range missing {
+		value, ok := values[key]
+		if !ok || value == nil {
+			continue
+		}
+		if err = c.localAdapter.Set(ctx, key, value, duration); err != nil {
+			return nil, err
+		}
+		result[key] = gvar.New(value)
+	}
+	return result, nil
+}
+
+// getMisses 返回负缓存存储，`create` 为 true 时在其不存在时创建。
+func (c *Cache) getMisses(create bool) *AdapterMemory {
+	c.missesMu.Lock()
+	defer c.missesMu.Unlock()
+	if c.misses == nil && create {
+		c.misses = NewAdapterMemory()
+	}
+	return c.misses
+}
+
+// Remove 从缓存中删除一个或多个键，并返回最后一个被删除键的值，同时删除这些键的负缓存标记。
+func (c *Cache) Remove(ctx context.Context, keys ...interface{}) (*gvar.Var, error) {
+	if misses := c.getMisses(false); misses != nil {
+		if _, err := misses.Remove(ctx, keys...); err != nil {
+			return nil, err
+		}
+	}
+	return c.localAdapter.Remove(ctx, keys...)
+}
+
+// Clear 清空缓存中的所有数据，同时清空负缓存标记。
+func (c *Cache) Clear(ctx context.Context) error {
+	if misses := c.getMisses(false); misses != nil {
+		if err := misses.Clear(ctx); err != nil {
+			return err
+		}
+	}
+	return c.localAdapter.Clear(ctx)
+}
+
+// Close 关闭缓存，同时关闭负缓存存储。
+func (c *Cache) Close(ctx context.Context) error {
+	if misses := c.getMisses(false); misses != nil {
+		if err := misses.Close(ctx); err != nil {
+			return err
+		}
+	}
+	return c.localAdapter.Close(ctx)
+}
+
 // Removes 删除缓存中的 `keys`。
 func (c *Cache) Removes(ctx context.Context, keys []interface{}) error {
 	_, err := c.Remove(ctx, keys...)
@@ -60,3 +213,109 @@ func (c *Cache) KeyStrings(ctx context.Context) ([]string, error) {
 	}
 	return gconv.Strings(keys), nil
 }
+
+// KeysByPattern 返回缓存中字符串形式匹配通配符 `pattern` 的所有键，支持 path.Match 风格的 `*` 和 `?` 通配符。
+// 如果当前适配器未实现按通配符检索，则遍历 Keys 的结果进行匹配。
+// 注意：此操作需要遍历全部缓存键，缓存较大时开销较高。
+func (c *Cache) KeysByPattern(ctx context.Context, pattern string) ([]interface{}, error) {
+	if adapter, ok := c.localAdapter.(iKeysByPattern); ok {
+		return adapter.KeysByPattern(ctx, pattern)
+	}
+	if err := checkKeyPattern(pattern); err != nil {
+		return nil, err
+	}
+	keys, err := c.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedKeys := make([]interface{}, 0)
+	for _, key := range keys {
+		if matched, _ := path.Match(pattern, gconv.String(key)); matched {
+			matchedKeys = append(matchedKeys, key)
+		}
+	}
+	return matchedKeys, nil
+}
+
+// WithPrefix 返回一个带命名空间的缓存视图，该视图的所有操作都会自动为键添加 `prefix` 前缀。
+// 视图与当前缓存共享底层数据，但 Keys、Size、Data 等操作只返回当前前缀下的数据，
+// Clear 也只会清除当前前缀下的数据。
+func (c *Cache) WithPrefix(prefix string) *Cache {
+	return NewWithAdapter(newAdapterPrefix(c.localAdapter, prefix))
+}
+
+// Rename 将 `oldKey` 的值移动到 `newKey`，并保留其剩余的过期时间。
+// 如果 `oldKey` 不存在于缓存中，则返回 false 且不做任何操作。
+// 如果 `newKey` 已存在，则会被覆盖。
+//
+// 注意，此操作不是原子的，由读取、写入和删除三个步骤组成。
+func (c *Cache) Rename(ctx context.Context, oldKey, newKey interface{}) (bool, error) {
+	value, err := c.Get(ctx, oldKey)
+	if err != nil || value == nil {
+		return false, err
+	}
+	if oldKey == newKey {
+		return true, nil
+	}
+	expire, err := c.GetExpire(ctx, oldKey)
+	if err != nil {
+		return false, err
+	}
+	if expire < 0 {
+		return false, nil
+	}
+	if expire > 0 && expire < time.Millisecond {
+		expire = time.Millisecond
+	}
+	if err = c.Set(ctx, newKey, value.Val(), expire); err != nil {
+		return false, err
+	}
+	if _, err = c.Remove(ctx, oldKey); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Increment 原子地将 `key` 的整数值增加 `delta`，并返回新值。
+// 不存在的键视为 0，已存在的键保留其过期时间；现有值不是整数类型时返回错误。
+// 如果当前适配器不支持原子自增，则返回错误。
+func (c *Cache) Increment(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	if adapter, ok := c.localAdapter.(iIncrement); ok {
+		return adapter.Increment(ctx, key, delta)
+	}
+	return 0, gerror.NewCodef(gcode.CodeNotSupported, `adapter "%T" does not support Increment`, c.localAdapter)
+}
+
+// Decrement 原子地将 `key` 的整数值减少 `delta`，并返回新值。
+// 也请参阅 Increment。
+func (c *Cache) Decrement(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	return c.Increment(ctx, key, -delta)
+}
+
+// Lock 尝试获取以 `key` 标识的锁，锁在 `ttl` 后自动过期，以防持有者异常退出导致死锁。
+// 获取成功时返回 true 和用于释放锁的函数 `release`；锁已被占用时返回 false，此时 `release` 不做任何操作。
+// `release` 只会删除当前持有者写入的锁，锁过期后被其他调用者重新获取时不会被误删。
+//
+// 锁基于 SetIfNotExist 实现：使用内存适配器时只能在当前进程内互斥；
+// 跨进程互斥需要使用 Redis 等共享存储的适配器，且释放锁时的检查与删除不是原子的。
+func (c *Cache) Lock(ctx context.Context, key interface{}, ttl time.Duration) (acquired bool, release func(), err error) {
+	release = func() {}
+	if ttl <= 0 {
+		return false, release, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid lock ttl "%s", it should be greater than 0`, ttl)
+	}
+	token := grand.S(32)
+	if acquired, err = c.SetIfNotExist(ctx, key, token, ttl); err != nil || !acquired {
+		return false, release, err
+	}
+	// 再次确认锁的持有者，避免并发写入时多个调用者同时认为获取成功。
+	value, err := c.Get(ctx, key)
+	if err != nil || value == nil || value.String() != token {
+		return false, release, err
+	}
+	release = func() {
+		if v, _ := c.Get(ctx, key); v != nil && v.String() == token {
+			_, _ = c.Remove(ctx, key)
+		}
+	}
+	return true, release, nil
+}
@@ -0,0 +1,143 @@
+package gcache_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestAdapterFile_Persist(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		path = filepath.Join(t.TempDir(), "cache.json")
+	)
+	adapter, err := gcache.NewAdapterFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = adapter.Set(ctx, "name", "john", 0)
+	_ = adapter.Set(ctx, "counter", 1, time.Hour)
+	_ = adapter.Set(ctx, "short", "gone", 50*time.Millisecond)
+	if err = adapter.Set(ctx, "func", func() {}, 0); err == nil {
+		t.Error("expected error for non-serializable value")
+	}
+	if err = adapter.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	restored, err := gcache.NewAdapterFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close(ctx)
+	if v, _ := restored.Get(ctx, "name"); v.String() != "john" {
+		t.Errorf("name = %v, want john", v)
+	}
+	if n, err := restored.Increment(ctx, "counter", 1); err != nil || n != 2 {
+		t.Errorf("Increment = %d, %v, want 2", n, err)
+	}
+	if expire, _ := restored.GetExpire(ctx, "counter"); expire <= 0 || expire > time.Hour {
+		t.Errorf("counter expire = %v, want (0, 1h]", expire)
+	}
+	for _, key := range []string{"short", "func"} {
+		if ok, _ := restored.Contains(ctx, key); ok {
+			t.Errorf("%s should not be restored", key)
+		}
+	}
+}
+
+func TestAdapterFile_DebouncedFlush(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		path = filepath.Join(t.TempDir(), "cache.json")
+	)
+	adapter, err := gcache.NewAdapterFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adapter.Close(ctx)
+	adapter.SetFlushDelay(50 * time.Millisecond)
+	_ = adapter.Set(ctx, "a", 1, 0)
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("file written before the flush delay")
+	}
+	time.Sleep(300 * time.Millisecond)
+	if _, err = os.Stat(path); err != nil {
+		t.Fatalf("file not written after the flush delay: %v", err)
+	}
+}
+
+func TestAdapterFile_ReadsDoNotFlush(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		path = filepath.Join(t.TempDir(), "cache.json")
+		f    = func(ctx context.Context) (interface{}, error) { return "computed", nil }
+	)
+	adapter, err := gcache.NewAdapterFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adapter.Close(ctx)
+	adapter.SetFlushDelay(0)
+	_ = adapter.Set(ctx, "a", 1, 0)
+	if err = os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _ = adapter.GetOrSet(ctx, "a", 2, 0)
+	_, _ = adapter.GetOrSetFunc(ctx, "a", f, 0)
+	_, _ = adapter.GetOrSetFuncLock(ctx, "a", f, 0)
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("cache hit should not rewrite the file")
+	}
+
+	if v, _ := adapter.GetOrSetFunc(ctx, "b", f, 0); v.String() != "computed" {
+		t.Errorf("GetOrSetFunc = %v, want computed", v)
+	}
+	if _, err = os.Stat(path); err != nil {
+		t.Fatalf("cache miss should write the file: %v", err)
+	}
+}
+
+func TestAdapterFile_Import(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		path = filepath.Join(t.TempDir(), "cache.json")
+		src  = gcache.NewAdapterMemory()
+	)
+	defer src.Close(ctx)
+	_ = src.Set(ctx, "name", "john", 0)
+	_ = src.Set(ctx, "score", 9.5, time.Hour)
+	data, _, _ := src.Export(ctx)
+
+	adapter, err := gcache.NewAdapterFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter.SetFlushDelay(0)
+	if imported, err := adapter.Import(ctx, data, true); err != nil || imported != 2 {
+		t.Fatalf("Import = %d, %v, want 2", imported, err)
+	}
+
+	// 导入的数据已写入文件，不关闭原缓存也可以从文件恢复。
+	restored, err := gcache.NewAdapterFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close(ctx)
+	if v, _ := restored.Get(ctx, "name"); v.String() != "john" {
+		t.Errorf("name = %v, want john", v)
+	}
+	if v, _ := restored.Get(ctx, "score"); v == nil || v.Val() != 9.5 {
+		t.Errorf("score = %#v, want 9.5", v)
+	}
+	if expire, _ := restored.GetExpire(ctx, "score"); expire <= 0 || expire > time.Hour {
+		t.Errorf("score expire = %v, want (0, 1h]", expire)
+	}
+	_ = adapter.Close(ctx)
+}
@@ -0,0 +1,139 @@
+package gcache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestAdapterTiered_Get(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		l1     = gcache.NewAdapterMemory()
+		l2     = gcache.NewAdapterMemory()
+		tiered = gcache.NewAdapterTiered(l1, l2, time.Minute)
+	)
+	defer tiered.Close(ctx)
+
+	// L2 命中时以不超过 l1TTL 的过期时间写入 L1。
+	_ = l2.Set(ctx, "k", "v", time.Hour)
+	v, err := tiered.Get(ctx, "k")
+	if err != nil || v.String() != "v" {
+		t.Fatalf("Get = %v, %v, want v", v, err)
+	}
+	if v, _ = l1.Get(ctx, "k"); v.String() != "v" {
+		t.Errorf("L1 should be populated on an L2 hit, got %v", v)
+	}
+	if expire, _ := l1.GetExpire(ctx, "k"); expire <= 0 || expire > time.Minute {
+		t.Errorf("L1 expire = %v, want capped by l1TTL", expire)
+	}
+
+	// L2 中过期时间短于 l1TTL 的数据在 L1 中使用较短的过期时间。
+	_ = l2.Set(ctx, "short", 1, 2*time.Second)
+	_, _ = tiered.Get(ctx, "short")
+	if expire, _ := l1.GetExpire(ctx, "short"); expire <= 0 || expire > 2*time.Second {
+		t.Errorf("L1 expire = %v, want at most 2s", expire)
+	}
+
+	// 永不过期的数据在 L1 中的过期时间也受 l1TTL 限制。
+	_ = l2.Set(ctx, "forever", 1, 0)
+	_, _ = tiered.Get(ctx, "forever")
+	if expire, _ := l1.GetExpire(ctx, "forever"); expire <= 0 || expire > time.Minute {
+		t.Errorf("L1 expire = %v, want capped by l1TTL", expire)
+	}
+
+	// L1 命中时不读取 L2。
+	_ = l1.Set(ctx, "only-l1", "l1", 0)
+	if v, _ = tiered.Get(ctx, "only-l1"); v.String() != "l1" {
+		t.Errorf("Get = %v, want l1", v)
+	}
+
+	if v, err = tiered.Get(ctx, "missing"); err != nil || v != nil {
+		t.Errorf("Get(missing) = %v, %v, want nil", v, err)
+	}
+	if ok, _ := l1.Contains(ctx, "missing"); ok {
+		t.Error("missing keys should not be populated into L1")
+	}
+}
+
+func TestAdapterTiered_Set(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		l1     = gcache.NewAdapterMemory()
+		l2     = gcache.NewAdapterMemory()
+		tiered = gcache.NewAdapterTiered(l1, l2, time.Minute)
+	)
+	defer tiered.Close(ctx)
+
+	_ = tiered.Set(ctx, "k", "v", time.Hour)
+	_ = tiered.SetMap(ctx, map[interface{}]interface{}{"a": 1, "b": 2}, 0)
+	for _, key := range []interface{}{"k", "a", "b"} {
+		for name, adapter := range map[string]gcache.Adapter{"L1": l1, "L2": l2} {
+			if ok, _ := adapter.Contains(ctx, key); !ok {
+				t.Errorf("%s should contain %v after write-through", name, key)
+			}
+		}
+	}
+	if expire, _ := l1.GetExpire(ctx, "k"); expire > time.Minute {
+		t.Errorf("L1 expire = %v, want capped by l1TTL", expire)
+	}
+	if expire, _ := l2.GetExpire(ctx, "k"); expire <= time.Minute {
+		t.Errorf("L2 expire = %v, want about 1h", expire)
+	}
+
+	// 更新只写入 L2 并使 L1 失效，下次读取时重新加载。
+	if _, _, err := tiered.Update(ctx, "k", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := l1.Contains(ctx, "k"); ok {
+		t.Error("Update should invalidate L1")
+	}
+	if v, _ := tiered.Get(ctx, "k"); v.String() != "v2" {
+		t.Errorf("Get after Update = %v, want v2", v)
+	}
+
+	if _, err := tiered.Remove(ctx, "k", "a"); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []interface{}{"k", "a"} {
+		if ok, _ := tiered.Contains(ctx, key); ok {
+			t.Errorf("%v should be removed from both tiers", key)
+		}
+	}
+
+	if err := tiered.Clear(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for name, adapter := range map[string]gcache.Adapter{"L1": l1, "L2": l2} {
+		if size, _ := adapter.Size(ctx); size != 0 {
+			t.Errorf("%s size after Clear = %d, want 0", name, size)
+		}
+	}
+}
+
+func TestAdapterTiered_Cache(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		l2    = gcache.NewAdapterMemory()
+		cache = gcache.NewWithAdapter(gcache.NewAdapterTiered(gcache.NewAdapterMemory(), l2, 0))
+		calls int
+	)
+	defer cache.Close(ctx)
+	for i := 0; i < 3; i++ {
+		v, err := cache.GetOrSetFunc(ctx, "k", func(ctx context.Context) (interface{}, error) {
+			calls++
+			return "computed", nil
+		}, time.Minute)
+		if err != nil || v.String() != "computed" {
+			t.Fatalf("GetOrSetFunc = %v, %v", v, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("f called %d times, want 1", calls)
+	}
+	if v, _ := l2.Get(ctx, "k"); v.String() != "computed" {
+		t.Errorf("L2 value = %v, want computed", v)
+	}
+}
@@ -0,0 +1,92 @@
+package gcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+)
+
+// rawSize 返回底层数据中的项数，包括已过期但尚未被清理的项。
+func (c *AdapterMemory) rawSize() int {
+	c.data.mu.RLock()
+	defer c.data.mu.RUnlock()
+	return len(c.data.data)
+}
+
+// waitReclaimed 等待过期键被清理，返回所用时间，超过 `timeout` 时返回 false。
+func waitReclaimed(c *AdapterMemory, timeout time.Duration) (time.Duration, bool) {
+	start := time.Now()
+	for time.Since(start) < timeout {
+		if c.rawSize() == 0 {
+			return time.Since(start), true
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return timeout, false
+}
+
+func TestAdapterMemory_SetCleanupInterval(t *testing.T) {
+	ctx := context.Background()
+	c := NewAdapterMemory()
+	defer c.Close(ctx)
+	c.SetCleanupInterval(10 * time.Millisecond)
+	c.SetExpiryBucketSpan(10 * time.Millisecond)
+
+	for i := 0; i < 100; i++ {
+		_ = c.Set(ctx, i, i, 20*time.Millisecond)
+	}
+	// 默认的 1 秒清理间隔和 1 秒过期桶至少需要 1 秒才能回收。
+	if elapsed, ok := waitReclaimed(c, 700*time.Millisecond); !ok {
+		t.Errorf("expired keys were not reclaimed within %v, %d left", elapsed, c.rawSize())
+	}
+}
+
+func TestAdapterMemory_SetExpiryBucketSpan_Rebuild(t *testing.T) {
+	ctx := context.Background()
+	c := NewAdapterMemory()
+	defer c.Close(ctx)
+	// 先按默认跨度分桶，再缩小跨度，已有的键会在下一次清理时重新分组。
+	_ = c.Set(ctx, "k", "v", 20*time.Millisecond)
+	c.SetExpiryBucketSpan(10 * time.Millisecond)
+	c.SetCleanupInterval(10 * time.Millisecond)
+
+	if elapsed, ok := waitReclaimed(c, 700*time.Millisecond); !ok {
+		t.Errorf("expired key was not reclaimed within %v after changing the bucket span", elapsed)
+	}
+}
+
+func TestAdapterMemory_SetCleanupInterval_NoLeak(t *testing.T) {
+	ctx := context.Background()
+	c := NewAdapterMemory()
+	entries := []*gtimer.Entry{c.cleanupEntry}
+	for _, interval := range []time.Duration{50 * time.Millisecond, 20 * time.Millisecond, 0} {
+		c.SetCleanupInterval(interval)
+		entries = append(entries, c.cleanupEntry)
+	}
+	for i, entry := range entries[:len(entries)-1] {
+		if entry.Status() != gtimer.StatusClosed {
+			t.Errorf("replaced cleanup entry %d is not closed", i)
+		}
+	}
+	current := c.cleanupEntry
+	if current.Status() == gtimer.StatusClosed {
+		t.Error("current cleanup entry should be running")
+	}
+
+	// 设置相同的间隔不会替换定时器。
+	c.SetCleanupInterval(time.Second)
+	if c.cleanupEntry != current {
+		t.Error("setting the same interval should keep the cleanup entry")
+	}
+
+	_ = c.Close(ctx)
+	if current.Status() != gtimer.StatusClosed {
+		t.Error("Close should close the cleanup entry")
+	}
+	c.SetCleanupInterval(10 * time.Millisecond)
+	if c.cleanupEntry != nil {
+		t.Error("SetCleanupInterval after Close should not register a timer")
+	}
+}
@@ -0,0 +1,57 @@
+package gcache_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+)
+
+func TestAdapterMemory_ClearByPrefix(t *testing.T) {
+	for name, adapter := range map[string]*gcache.AdapterMemory{
+		"memory": gcache.NewAdapterMemory(),
+		"lru":    gcache.NewAdapterMemoryLru(100),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			defer adapter.Close(ctx)
+			_ = adapter.SetMap(ctx, map[interface{}]interface{}{
+				"tenant:1:user":  1,
+				"tenant:1:role":  2,
+				"tenant:10:user": 3,
+				"tenant:2:user":  4,
+				"other":          5,
+			}, time.Minute)
+
+			removed, err := adapter.ClearByPrefix(ctx, "tenant:1:")
+			if err != nil || removed != 2 {
+				t.Fatalf("ClearByPrefix = %d, %v, want 2", removed, err)
+			}
+			keys, _ := adapter.Keys(ctx)
+			got := gconv.Strings(keys)
+			sort.Strings(got)
+			want := []string{"other", "tenant:10:user", "tenant:2:user"}
+			if len(got) != len(want) {
+				t.Fatalf("Keys = %v, want %v", got, want)
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("Keys = %v, want %v", got, want)
+					break
+				}
+			}
+
+			if removed, _ = adapter.ClearByPrefix(ctx, "missing:"); removed != 0 {
+				t.Errorf("ClearByPrefix(missing) = %d, want 0", removed)
+			}
+			// A removed key can be set again.
+			_ = adapter.Set(ctx, "tenant:1:user", "new", time.Minute)
+			if v, _ := adapter.Get(ctx, "tenant:1:user"); v.String() != "new" {
+				t.Errorf("Get after re-set = %v, want new", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package gcache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestCache_GetOrSetFunc_CanceledContext(t *testing.T) {
+	var (
+		cache       = gcache.New()
+		ctx, cancel = context.WithCancel(context.Background())
+		calls       int
+		f           = func(ctx context.Context) (interface{}, error) {
+			calls++
+			return "value", nil
+		}
+	)
+	defer cache.Close(context.Background())
+	cancel()
+
+	if _, err := cache.GetOrSetFunc(ctx, "k1", f, time.Minute); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetOrSetFunc err = %v, want context.Canceled", err)
+	}
+	if _, err := cache.GetOrSetFuncLock(ctx, "k2", f, time.Minute); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetOrSetFuncLock err = %v, want context.Canceled", err)
+	}
+	if calls != 0 {
+		t.Errorf("f called %d times, want 0", calls)
+	}
+	if size, _ := cache.Size(context.Background()); size != 0 {
+		t.Errorf("Size = %d, want 0", size)
+	}
+}
+
+func TestCache_GetOrSetFunc_CanceledDuringCompute(t *testing.T) {
+	var (
+		cache = gcache.New()
+		bg    = context.Background()
+	)
+	defer cache.Close(bg)
+
+	for name, getOrSet := range map[string]func(ctx context.Context, key string, f gcache.Func) error{
+		"GetOrSetFunc": func(ctx context.Context, key string, f gcache.Func) error {
+			_, err := cache.GetOrSetFunc(ctx, key, f, time.Minute)
+			return err
+		},
+		"GetOrSetFuncLock": func(ctx context.Context, key string, f gcache.Func) error {
+			_, err := cache.GetOrSetFuncLock(ctx, key, f, time.Minute)
+			return err
+		},
+	} {
+		ctx, cancel := context.WithCancel(bg)
+		err := getOrSet(ctx, name, func(ctx context.Context) (interface{}, error) {
+			cancel()
+			return "stale", nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("%s err = %v, want context.Canceled", name, err)
+		}
+		if ok, _ := cache.Contains(bg, name); ok {
+			t.Errorf("%s stored a value computed under a canceled context", name)
+		}
+	}
+
+	// A cached value is still returned for a canceled context.
+	if err := cache.Set(bg, "cached", "value", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(bg)
+	cancel()
+	v, err := cache.GetOrSetFunc(ctx, "cached", func(ctx context.Context) (interface{}, error) {
+		t.Error("f should not be called on a hit")
+		return nil, nil
+	}, time.Minute)
+	if err != nil || v.String() != "value" {
+		t.Errorf("GetOrSetFunc = %v, %v, want value", v, err)
+	}
+}
@@ -0,0 +1,152 @@
+package gcache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestAdapterMemory_ExportImport(t *testing.T) {
+	var (
+		ctx = context.Background()
+		src = gcache.NewAdapterMemory()
+		dst = gcache.NewAdapterMemory()
+	)
+	defer src.Close(ctx)
+	defer dst.Close(ctx)
+
+	_ = src.Set(ctx, "counter", 10, 0)
+	_ = src.Set(ctx, "name", "john", time.Minute)
+	_ = src.Set(ctx, "map", map[string]interface{}{"a": "b"}, 0)
+	_ = src.Set(ctx, 1, "int key", 0)
+	_ = src.Set(ctx, "func", func() {}, 0)
+
+	data, skipped, err := src.Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skipped != 2 {
+		t.Errorf("skipped = %d, want 2", skipped)
+	}
+	imported, err := dst.Import(ctx, data, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported != 3 {
+		t.Errorf("imported = %d, want 3", imported)
+	}
+
+	// Integers are restored as int64 and keep working with Increment.
+	if v, _ := dst.Get(ctx, "counter"); fmt.Sprintf("%T", v.Val()) != "int64" {
+		t.Errorf("counter type = %T, want int64", v.Val())
+	}
+	if n, err := dst.Increment(ctx, "counter", 1); err != nil || n != 11 {
+		t.Errorf("Increment = %d, %v, want 11", n, err)
+	}
+	if v, _ := dst.Get(ctx, "name"); v.String() != "john" {
+		t.Errorf("name = %v, want john", v)
+	}
+	if v, _ := dst.Get(ctx, "map"); v.Map()["a"] != "b" {
+		t.Errorf("map = %v", v)
+	}
+	if expire, _ := dst.GetExpire(ctx, "name"); expire <= 0 || expire > time.Minute {
+		t.Errorf("name expire = %v, want (0, 1m]", expire)
+	}
+	if expire, _ := dst.GetExpire(ctx, "counter"); expire != 0 && expire < 24*time.Hour {
+		t.Errorf("counter expire = %v, want never expiring", expire)
+	}
+}
+
+func TestAdapterMemory_Import_Overwrite(t *testing.T) {
+	var (
+		ctx = context.Background()
+		src = gcache.NewAdapterMemory()
+		dst = gcache.NewAdapterMemory()
+	)
+	defer src.Close(ctx)
+	defer dst.Close(ctx)
+
+	_ = src.Set(ctx, "a", "new", 0)
+	_ = src.Set(ctx, "b", "new", 0)
+	_ = dst.Set(ctx, "a", "old", 0)
+	data, _, _ := src.Export(ctx)
+
+	imported, err := dst.Import(ctx, data, false)
+	if err != nil || imported != 1 {
+		t.Fatalf("Import = %d, %v, want 1", imported, err)
+	}
+	if v, _ := dst.Get(ctx, "a"); v.String() != "old" {
+		t.Errorf("a = %v, want old", v)
+	}
+	if imported, _ = dst.Import(ctx, data, true); imported != 2 {
+		t.Errorf("imported = %d, want 2", imported)
+	}
+	if v, _ := dst.Get(ctx, "a"); v.String() != "new" {
+		t.Errorf("a = %v, want new", v)
+	}
+}
+
+func TestAdapterMemory_Import_Expired(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		dst   = gcache.NewAdapterMemory()
+		stale = time.Now().Add(-time.Minute).UnixMilli()
+		data  = fmt.Sprintf(`{"exportedAt":%d,"items":[`+
+			`{"key":"expired","value":"x","ttl":1000},`+
+			`{"key":"alive","value":"y","ttl":3600000},`+
+			`{"key":"forever","value":"z","ttl":0}]}`, stale)
+	)
+	defer dst.Close(ctx)
+
+	imported, err := dst.Import(ctx, []byte(data), true)
+	if err != nil || imported != 2 {
+		t.Fatalf("Import = %d, %v, want 2", imported, err)
+	}
+	if ok, _ := dst.Contains(ctx, "expired"); ok {
+		t.Error("expired item should be skipped")
+	}
+	if expire, _ := dst.GetExpire(ctx, "alive"); expire <= 58*time.Minute || expire > 59*time.Minute {
+		t.Errorf("alive expire = %v, want about 59m", expire)
+	}
+	if _, err = dst.Import(ctx, []byte("not json"), true); err == nil {
+		t.Error("expected error for invalid snapshot")
+	}
+}
+
+func TestAdapterMemory_Import_Numbers(t *testing.T) {
+	var (
+		ctx = context.Background()
+		src = gcache.NewAdapterMemory()
+		dst = gcache.NewAdapterMemory()
+	)
+	defer src.Close(ctx)
+	defer dst.Close(ctx)
+
+	_ = src.Set(ctx, "int", 42, 0)
+	_ = src.Set(ctx, "float", 3.14, 0)
+	_ = src.Set(ctx, "negative", -0.5, 0)
+	_ = src.Set(ctx, "large", 1e300, 0)
+	data, _, _ := src.Export(ctx)
+	if _, err := dst.Import(ctx, data, true); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key  string
+		want interface{}
+	}{
+		{"int", int64(42)},
+		{"float", 3.14},
+		{"negative", -0.5},
+		{"large", 1e300},
+	}
+	for _, tt := range tests {
+		v, _ := dst.Get(ctx, tt.key)
+		if v == nil || v.Val() != tt.want {
+			t.Errorf("%s = %v, want %#v", tt.key, v, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package gcache_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestCache_GetOrSetMany(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		calls [][]interface{}
+	)
+	defer cache.Close(ctx)
+	_ = cache.Set(ctx, 1, "one", 0)
+	_ = cache.Set(ctx, 3, "three", 0)
+
+	load := func(ctx context.Context, missing []interface{}) (map[interface{}]interface{}, error) {
+		calls = append(calls, missing)
+		values := make(map[interface{}]interface{})
+		for _, key := range missing {
+			if key.(int) < 5 {
+				values[key] = key.(int) * 10
+			}
+		}
+		return values, nil
+	}
+	result, err := cache.GetOrSetMany(ctx, []interface{}{1, 2, 3, 4, 5}, load, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]interface{}{{2, 4, 5}}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("f calls = %v, want %v", calls, want)
+	}
+	got := make(map[interface{}]interface{}, len(result))
+	for k, v := range result {
+		got[k] = v.Val()
+	}
+	want := map[interface{}]interface{}{1: "one", 2: 20, 3: "three", 4: 40}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetOrSetMany = %v, want %v", got, want)
+	}
+
+	// The computed values are cached, absent keys are not.
+	if v, _ := cache.Get(ctx, 4); v.Int() != 40 {
+		t.Errorf("Get(4) = %v, want 40", v)
+	}
+	if ok, _ := cache.Contains(ctx, 5); ok {
+		t.Error("keys not returned by f should not be cached")
+	}
+
+	// f is not called when nothing is missing.
+	calls = nil
+	if _, err = cache.GetOrSetMany(ctx, []interface{}{1, 2, 4}, load, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("f should not be called without missing keys, got %v", calls)
+	}
+}
+
+func TestCache_GetOrSetMany_Error(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		errF  = errors.New("load failed")
+	)
+	defer cache.Close(ctx)
+	_, err := cache.GetOrSetMany(ctx, []interface{}{"a"}, func(ctx context.Context, missing []interface{}) (map[interface{}]interface{}, error) {
+		return map[interface{}]interface{}{"a": 1}, errF
+	}, 0)
+	if err != errF {
+		t.Errorf("err = %v, want %v", err, errF)
+	}
+	if ok, _ := cache.Contains(ctx, "a"); ok {
+		t.Error("values should not be cached when f fails")
+	}
+}
@@ -0,0 +1,68 @@
+package gcache_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestCache_Increment_Concurrent(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		wg    sync.WaitGroup
+		want  int64
+	)
+	defer cache.Close(ctx)
+
+	for i := 1; i <= 50; i++ {
+		delta := int64(i)
+		want += delta*20 - 20
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := cache.Increment(ctx, "counter", delta); err != nil {
+					t.Error(err)
+				}
+				if _, err := cache.Decrement(ctx, "counter", 1); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	v, _ := cache.Get(ctx, "counter")
+	if v.Int64() != want {
+		t.Errorf("counter = %v, want %d", v, want)
+	}
+}
+
+func TestCache_Increment(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+	)
+	defer cache.Close(ctx)
+
+	if v, err := cache.Decrement(ctx, "missing", 3); err != nil || v != -3 {
+		t.Errorf("Decrement(missing) = %d, %v, want -3", v, err)
+	}
+
+	// An existing key keeps its expiration.
+	_ = cache.Set(ctx, "ttl", 10, 10*time.Second)
+	if v, err := cache.Increment(ctx, "ttl", 5); err != nil || v != 15 {
+		t.Errorf("Increment(ttl) = %d, %v, want 15", v, err)
+	}
+	if expire, _ := cache.GetExpire(ctx, "ttl"); expire <= 9*time.Second || expire > 10*time.Second {
+		t.Errorf("GetExpire(ttl) = %v, want about 10s", expire)
+	}
+
+	_ = cache.Set(ctx, "name", "john", 0)
+	if _, err := cache.Increment(ctx, "name", 1); err == nil {
+		t.Error("incrementing a non-integer value should fail")
+	}
+}
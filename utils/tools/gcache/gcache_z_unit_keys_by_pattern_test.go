@@ -0,0 +1,61 @@
+package gcache_test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+)
+
+// plainAdapter 隐藏 AdapterMemory 的 KeysByPattern，用于测试 Cache 的回退实现。
+type plainAdapter struct {
+	gcache.Adapter
+}
+
+func TestCache_KeysByPattern(t *testing.T) {
+	ctx := context.Background()
+	for name, cache := range map[string]*gcache.Cache{
+		"memory":   gcache.New(),
+		"fallback": gcache.NewWithAdapter(plainAdapter{gcache.NewAdapterMemory()}),
+	} {
+		_ = cache.SetMap(ctx, map[interface{}]interface{}{
+			"user:1":  1,
+			"user:2":  2,
+			"user:10": 10,
+			"order:1": 1,
+			100:       100,
+		}, 0)
+		_ = cache.Set(ctx, "user:expired", 0, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+
+		tests := []struct {
+			pattern string
+			want    []string
+		}{
+			{"user:*", []string{"user:1", "user:10", "user:2"}},
+			{"user:?", []string{"user:1", "user:2"}},
+			{"*:1", []string{"order:1", "user:1"}},
+			{"1*", []string{"100"}},
+			{"product:*", []string{}},
+		}
+		for _, tt := range tests {
+			keys, err := cache.KeysByPattern(ctx, tt.pattern)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			got := gconv.Strings(keys)
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s: KeysByPattern(%q) = %v, want %v", name, tt.pattern, got, tt.want)
+			}
+		}
+		if _, err := cache.KeysByPattern(ctx, "user:["); err == nil {
+			t.Errorf("%s: malformed pattern should fail", name)
+		}
+		_ = cache.Close(ctx)
+	}
+}
@@ -0,0 +1,102 @@
+package gcache_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestCache_Lock_Release(t *testing.T) {
+	ctx := context.Background()
+	cache := gcache.New()
+	defer cache.Close(ctx)
+
+	acquired, release, err := cache.Lock(ctx, "lock", time.Minute)
+	if err != nil || !acquired {
+		t.Fatalf("Lock = %v, %v, want acquired", acquired, err)
+	}
+	// 锁被占用时再次获取失败。
+	acquired2, release2, err := cache.Lock(ctx, "lock", time.Minute)
+	if err != nil || acquired2 {
+		t.Fatalf("second Lock = %v, %v, want not acquired", acquired2, err)
+	}
+	// 获取失败返回的 release 不做任何操作。
+	release2()
+	if acquired2, _, _ = cache.Lock(ctx, "lock", time.Minute); acquired2 {
+		t.Fatal("failed lock's release should not release the held lock")
+	}
+
+	release()
+	acquired, release, err = cache.Lock(ctx, "lock", time.Minute)
+	if err != nil || !acquired {
+		t.Fatalf("Lock after release = %v, %v, want acquired", acquired, err)
+	}
+	release()
+}
+
+func TestCache_Lock_Expire(t *testing.T) {
+	ctx := context.Background()
+	cache := gcache.New()
+	defer cache.Close(ctx)
+
+	acquired, release, _ := cache.Lock(ctx, "lock", 50*time.Millisecond)
+	if !acquired {
+		t.Fatal("first Lock should be acquired")
+	}
+	if acquired, _, _ = cache.Lock(ctx, "lock", time.Minute); acquired {
+		t.Fatal("Lock should fail while held")
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	acquired, release2, _ := cache.Lock(ctx, "lock", time.Minute)
+	if !acquired {
+		t.Fatal("Lock should succeed after ttl expiry")
+	}
+	// 过期锁的 release 不会删除新持有者的锁。
+	release()
+	if acquired, _, _ = cache.Lock(ctx, "lock", time.Minute); acquired {
+		t.Error("stale release should not remove the new holder's lock")
+	}
+	release2()
+}
+
+func TestCache_Lock_InvalidTTL(t *testing.T) {
+	ctx := context.Background()
+	cache := gcache.New()
+	defer cache.Close(ctx)
+
+	for _, ttl := range []time.Duration{0, -time.Second} {
+		acquired, release, err := cache.Lock(ctx, "lock", ttl)
+		if err == nil || acquired || release == nil {
+			t.Errorf("Lock(ttl=%v) = %v, %v, want error with non-nil release", ttl, acquired, err)
+		}
+	}
+}
+
+func TestCache_Lock_Concurrent(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		cache    = gcache.New()
+		wg       sync.WaitGroup
+		acquired int32
+	)
+	defer cache.Close(ctx)
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ok, _, _ := cache.Lock(ctx, "lock", time.Minute); ok {
+				atomic.AddInt32(&acquired, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if acquired != 1 {
+		t.Errorf("acquired %d times, want 1", acquired)
+	}
+}
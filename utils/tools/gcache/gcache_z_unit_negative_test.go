@@ -0,0 +1,121 @@
+package gcache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+)
+
+func TestCache_GetOrSetFuncWithError_Negative(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		calls int
+		nilFn = func(ctx context.Context) (interface{}, error) {
+			calls++
+			return nil, nil
+		}
+	)
+	defer cache.Close(ctx)
+
+	for i := 0; i < 3; i++ {
+		v, err := cache.GetOrSetFuncWithError(ctx, "k", nilFn, time.Minute, time.Minute)
+		if err != nil || v != nil {
+			t.Fatalf("GetOrSetFuncWithError = %v, %v, want nil, nil", v, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("f called %d times, want 1", calls)
+	}
+
+	// The negative entry is not visible through the other read paths.
+	if v, _ := cache.Get(ctx, "k"); v != nil {
+		t.Errorf("Get = %v, want nil", v)
+	}
+	if ok, _ := cache.Contains(ctx, "k"); ok {
+		t.Error("Contains = true, want false")
+	}
+	if size, _ := cache.Size(ctx); size != 0 {
+		t.Errorf("Size = %d, want 0", size)
+	}
+	if data, _ := cache.Data(ctx); len(data) != 0 {
+		t.Errorf("Data = %v, want empty", data)
+	}
+	if expire, _ := cache.GetExpire(ctx, "k"); expire != -1 {
+		t.Errorf("GetExpire = %v, want -1", expire)
+	}
+	v, err := cache.GetOrSetFunc(ctx, "k", func(ctx context.Context) (interface{}, error) {
+		return "computed", nil
+	}, time.Minute)
+	if err != nil || v.String() != "computed" {
+		t.Errorf("GetOrSetFunc = %v, %v, want computed", v, err)
+	}
+}
+
+func TestCache_GetOrSetFuncWithError_RemoveAndExpire(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		calls int
+		nilFn = func(ctx context.Context) (interface{}, error) {
+			calls++
+			return nil, nil
+		}
+	)
+	defer cache.Close(ctx)
+
+	_, _ = cache.GetOrSetFuncWithError(ctx, "k", nilFn, time.Minute, time.Minute)
+	_, _ = cache.Remove(ctx, "k")
+	_, _ = cache.GetOrSetFuncWithError(ctx, "k", nilFn, time.Minute, time.Minute)
+	if calls != 2 {
+		t.Fatalf("f called %d times after Remove, want 2", calls)
+	}
+
+	_ = cache.Clear(ctx)
+	_, _ = cache.GetOrSetFuncWithError(ctx, "k", nilFn, time.Minute, 50*time.Millisecond)
+	if calls != 3 {
+		t.Fatalf("f called %d times after Clear, want 3", calls)
+	}
+	time.Sleep(100 * time.Millisecond)
+	_, _ = cache.GetOrSetFuncWithError(ctx, "k", nilFn, time.Minute, time.Minute)
+	if calls != 4 {
+		t.Fatalf("f called %d times after negative TTL, want 4", calls)
+	}
+}
+
+func TestCache_GetOrSetFuncWithError_ValueAndError(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		cache   = gcache.New()
+		calls   int
+		errTest = errors.New("test")
+	)
+	defer cache.Close(ctx)
+
+	// Errors are not cached.
+	for i := 0; i < 2; i++ {
+		_, err := cache.GetOrSetFuncWithError(ctx, "k", func(ctx context.Context) (interface{}, error) {
+			calls++
+			return nil, errTest
+		}, time.Minute, time.Minute)
+		if !errors.Is(err, errTest) {
+			t.Fatalf("err = %v, want %v", err, errTest)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("f called %d times, want 2", calls)
+	}
+
+	v, err := cache.GetOrSetFuncWithError(ctx, "k", func(ctx context.Context) (interface{}, error) {
+		return 1, nil
+	}, time.Minute, time.Minute)
+	if err != nil || v.Int() != 1 {
+		t.Fatalf("GetOrSetFuncWithError = %v, %v, want 1", v, err)
+	}
+	if v, _ = cache.Get(ctx, "k"); v.Int() != 1 {
+		t.Errorf("Get = %v, want 1", v)
+	}
+}
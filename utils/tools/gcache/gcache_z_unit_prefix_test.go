@@ -0,0 +1,120 @@
+package gcache_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcache"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+)
+
+func TestCache_WithPrefix(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		users = cache.WithPrefix("user:")
+		roles = cache.WithPrefix("role:")
+	)
+	defer cache.Close(ctx)
+
+	_ = users.Set(ctx, 1, "john", time.Minute)
+	_ = users.Set(ctx, 2, "smith", time.Minute)
+	_ = roles.Set(ctx, 1, "admin", time.Minute)
+	_ = cache.Set(ctx, "other", "value", time.Minute)
+
+	if v, _ := users.Get(ctx, 1); v.String() != "john" {
+		t.Errorf("users.Get(1) = %v, want john", v)
+	}
+	if v, _ := roles.Get(ctx, 1); v.String() != "admin" {
+		t.Errorf("roles.Get(1) = %v, want admin", v)
+	}
+	if v, _ := cache.Get(ctx, "user:1"); v.String() != "john" {
+		t.Errorf("cache.Get(user:1) = %v, want john", v)
+	}
+
+	keys, _ := users.Keys(ctx)
+	keyStrs := gconv.Strings(keys)
+	sort.Strings(keyStrs)
+	if len(keyStrs) != 2 || keyStrs[0] != "1" || keyStrs[1] != "2" {
+		t.Errorf("users.Keys = %v, want [1 2]", keyStrs)
+	}
+	if size, _ := users.Size(ctx); size != 2 {
+		t.Errorf("users.Size = %d, want 2", size)
+	}
+
+	if err := users.Clear(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if size, _ := users.Size(ctx); size != 0 {
+		t.Errorf("users.Size after Clear = %d, want 0", size)
+	}
+	if size, _ := cache.Size(ctx); size != 2 {
+		t.Errorf("cache.Size after users.Clear = %d, want 2", size)
+	}
+	if v, _ := roles.Get(ctx, 1); v.String() != "admin" {
+		t.Error("clearing one prefix should not affect another")
+	}
+}
+
+func TestCache_Rename(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+	)
+	defer cache.Close(ctx)
+
+	_ = cache.Set(ctx, "old", "value", 10*time.Second)
+	ok, err := cache.Rename(ctx, "old", "new")
+	if err != nil || !ok {
+		t.Fatalf("Rename = %v, %v, want true", ok, err)
+	}
+	if v, _ := cache.Get(ctx, "old"); v != nil {
+		t.Errorf("old key still present: %v", v)
+	}
+	if v, _ := cache.Get(ctx, "new"); v.String() != "value" {
+		t.Errorf("Get(new) = %v, want value", v)
+	}
+	expire, _ := cache.GetExpire(ctx, "new")
+	if expire <= 9*time.Second || expire > 10*time.Second {
+		t.Errorf("GetExpire(new) = %v, want the remaining ~10s", expire)
+	}
+
+	// Renaming over an existing key overwrites it.
+	_ = cache.Set(ctx, "a", 1, time.Minute)
+	_ = cache.Set(ctx, "b", 2, time.Minute)
+	if ok, _ = cache.Rename(ctx, "a", "b"); !ok {
+		t.Fatal("Rename(a, b) = false")
+	}
+	if v, _ := cache.Get(ctx, "b"); v.Int() != 1 {
+		t.Errorf("Get(b) = %v, want 1", v)
+	}
+
+	if ok, err = cache.Rename(ctx, "missing", "x"); ok || err != nil {
+		t.Errorf("Rename(missing) = %v, %v, want false, nil", ok, err)
+	}
+	if ok, _ := cache.Contains(ctx, "x"); ok {
+		t.Error("Rename of a missing key should not create the new key")
+	}
+}
+
+func TestCache_WithPrefix_Rename(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = gcache.New()
+		users = cache.WithPrefix("user:")
+	)
+	defer cache.Close(ctx)
+
+	_ = users.Set(ctx, "tmp", "john", time.Minute)
+	if ok, _ := users.Rename(ctx, "tmp", "1"); !ok {
+		t.Fatal("Rename in prefix view = false")
+	}
+	if v, _ := cache.Get(ctx, "user:1"); v.String() != "john" {
+		t.Errorf("cache.Get(user:1) = %v, want john", v)
+	}
+	if ok, _ := cache.Contains(ctx, "user:tmp"); ok {
+		t.Error("renamed key still present under the prefix")
+	}
+}
@@ -45,6 +45,12 @@ type IUnwrap interface {
 	Unwrap() error
 }
 
+// IRetryable 是 Retryable 功能的接口。
+type IRetryable interface {
+	Error() string
+	Retryable() bool
+}
+
 const (
 	// commaSeparatorSpace is the comma separator with space.
 	commaSeparatorSpace = ", "
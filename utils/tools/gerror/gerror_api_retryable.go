@@ -0,0 +1,78 @@
+package gerror
+
+import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"sync"
+)
+
+var (
+	// retryableCodes is the set of error codes that are considered retryable.
+	retryableCodes = map[int]struct{}{
+		gcode.CodeServerBusy.Code():       {},
+		gcode.CodeDbOperationError.Code(): {},
+	}
+	retryableCodesMu sync.RWMutex
+)
+
+// SetRetryableCodes replaces the retryable code set with given `codes`.
+// The default retryable codes are CodeServerBusy and CodeDbOperationError.
+func SetRetryableCodes(codes ...gcode.Code) {
+	m := make(map[int]struct{}, len(codes))
+	for _, code := range codes {
+		m[code.Code()] = struct{}{}
+	}
+	retryableCodesMu.Lock()
+	retryableCodes = m
+	retryableCodesMu.Unlock()
+}
+
+// AddRetryableCodes adds `codes` to the retryable code set.
+func AddRetryableCodes(codes ...gcode.Code) {
+	retryableCodesMu.Lock()
+	for _, code := range codes {
+		retryableCodes[code.Code()] = struct{}{}
+	}
+	retryableCodesMu.Unlock()
+}
+
+// IsRetryable checks and reports whether `err` is transient and the operation may be retried.
+// It returns true if any error in the chain is marked by MarkRetryable,
+// or the code of `err` is in the retryable code set.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if e, ok := err.(IRetryable); ok && e.Retryable() {
+		return true
+	}
+	if e, ok := err.(IUnwrap); ok {
+		if IsRetryable(e.Unwrap()) {
+			return true
+		}
+	}
+	return isRetryableCode(Code(err))
+}
+
+// MarkRetryable wraps `err` and marks it retryable regardless of its code.
+// It returns nil if given `err` is nil.
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		error:     err,
+		stack:     callers(),
+		retryable: true,
+	}
+}
+
+// isRetryableCode checks whether `code` is in the retryable code set.
+func isRetryableCode(code gcode.Code) bool {
+	if code == nil || code == gcode.CodeNil {
+		return false
+	}
+	retryableCodesMu.RLock()
+	_, ok := retryableCodes[code.Code()]
+	retryableCodesMu.RUnlock()
+	return ok
+}
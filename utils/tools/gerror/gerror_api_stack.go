@@ -14,17 +14,28 @@ const (
 )
 
 // Cause returns the root cause error of `err`.
+// It unwraps `err` layer by layer until reaching an error that no longer implements IUnwrap
+// or whose Unwrap returns nil, and returns that error itself, so a root sentinel error can be
+// compared by identity. An error implementing ICause but not IUnwrap is resolved by its Cause method.
+// It returns nil if `err` is nil.
 func Cause(err error) error {
 	if err == nil {
 		return nil
 	}
-	if e, ok := err.(ICause); ok {
-		return e.Cause()
+	for {
+		if e, ok := err.(IUnwrap); ok {
+			next := e.Unwrap()
+			if next == nil {
+				return err
+			}
+			err = next
+			continue
+		}
+		if e, ok := err.(ICause); ok {
+			return e.Cause()
+		}
+		return err
 	}
-	if e, ok := err.(IUnwrap); ok {
-		return Cause(e.Unwrap())
-	}
-	return err
 }
 
 // Stack returns the stack callers as string.
@@ -39,7 +50,7 @@ func Stack(err error) string {
 	return err.Error()
 }
 
-// Current creates and returns the current level error.
+// Current creates and returns the current level error, which is the outermost error without its wrapped chain.
 // It returns nil if current level error is nil.
 func Current(err error) error {
 	if err == nil {
@@ -1,7 +1,6 @@
 package gerror
 
 import (
-	"errors"
 	"fmt"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
 	"runtime"
@@ -10,10 +9,11 @@ import (
 
 // Error is custom error for additional features.
 type Error struct {
-	error error      // Wrapped error.
-	stack stack      // Stack array, which records the stack information when this error is created or wrapped.
-	text  string     // Custom Error text when Error is created, might be empty when its code is not nil.
-	code  gcode.Code // Error code if necessary.
+	error     error      // Wrapped error.
+	stack     stack      // Stack array, which records the stack information when this error is created or wrapped.
+	text      string     // Custom Error text when Error is created, might be empty when its code is not nil.
+	code      gcode.Code // Error code if necessary.
+	retryable bool       // Forces the error to be retryable regardless of its code.
 }
 
 const (
@@ -49,30 +49,12 @@ func (err *Error) Error() string {
 }
 
 // Cause returns the root cause error.
+// It is the same as the package function Cause, a root error is returned itself so that it can be compared by identity.
 func (err *Error) Cause() error {
 	if err == nil {
 		return nil
 	}
-	loop := err
-	for loop != nil {
-		if loop.error != nil {
-			if e, ok := loop.error.(*Error); ok {
-				// Internal Error struct.
-				loop = e
-			} else if e, ok := loop.error.(ICause); ok {
-				// Other Error that implements ApiCause interface.
-				return e.Cause()
-			} else {
-				return loop.error
-			}
-		} else {
-			// return loop
-			//
-			// To be compatible with Case of https://github.com/pkg/errors.
-			return errors.New(loop.text)
-		}
-	}
-	return nil
+	return Cause(err)
 }
 
 // Current creates and returns the current level error.
@@ -82,10 +64,11 @@ func (err *Error) Current() error {
 		return nil
 	}
 	return &Error{
-		error: nil,
-		stack: err.stack,
-		text:  err.text,
-		code:  err.code,
+		error:     nil,
+		stack:     err.stack,
+		text:      err.text,
+		code:      err.code,
+		retryable: err.retryable,
 	}
 }
 
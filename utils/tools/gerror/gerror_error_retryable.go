@@ -0,0 +1,13 @@
+package gerror
+
+// Retryable reports whether the error is transient and the operation may be retried.
+// It returns true if the error is marked by MarkRetryable, or its code is in the retryable code set.
+func (err *Error) Retryable() bool {
+	if err == nil {
+		return false
+	}
+	if err.retryable {
+		return true
+	}
+	return isRetryableCode(err.Code())
+}
@@ -0,0 +1,89 @@
+package gerror_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+)
+
+func TestCause(t *testing.T) {
+	if gerror.Cause(nil) != nil {
+		t.Error("Cause(nil) should be nil")
+	}
+
+	// A gerror sentinel wrapped three layers deep.
+	var (
+		sentinel = gerror.NewCode(gcode.CodeNotFound, "record not found")
+		err      = gerror.Wrap(gerror.Wrap(gerror.Wrap(sentinel, "layer 1"), "layer 2"), "layer 3")
+	)
+	if cause := gerror.Cause(err); cause != sentinel {
+		t.Errorf("Cause = %#v, want the sentinel itself", cause)
+	}
+	if gerror.Cause(sentinel) != sentinel {
+		t.Error("Cause of a root error should be itself")
+	}
+
+	// A standard error wrapped three layers deep, mixing gerror and fmt wrapping.
+	var (
+		stdSentinel = errors.New("io failure")
+		stdErr      = gerror.Wrap(fmt.Errorf("layer 2: %w", gerror.Wrap(stdSentinel, "layer 1")), "layer 3")
+	)
+	if cause := gerror.Cause(stdErr); cause != stdSentinel {
+		t.Errorf("Cause = %#v, want the standard sentinel", cause)
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	if gerror.Current(nil) != nil {
+		t.Error("Current(nil) should be nil")
+	}
+	var (
+		sentinel = errors.New("root")
+		err      = gerror.WrapCode(gcode.CodeInternalError, gerror.Wrap(gerror.Wrap(sentinel, "layer 1"), "layer 2"), "layer 3")
+		current  = gerror.Current(err)
+	)
+	if current.Error() != "layer 3" {
+		t.Errorf("Current = %q, want %q", current.Error(), "layer 3")
+	}
+	if gerror.Code(current) != gcode.CodeInternalError {
+		t.Errorf("Current code = %v, want CodeInternalError", gerror.Code(current))
+	}
+	if gerror.Unwrap(current) != nil {
+		t.Error("Current should not keep the wrapped chain")
+	}
+	if plain := gerror.Current(sentinel); plain != sentinel {
+		t.Error("Current of a non-gerror error should be itself")
+	}
+}
+
+func TestError_Cause(t *testing.T) {
+	var (
+		sentinel    = gerror.NewCode(gcode.CodeNotFound, "record not found")
+		stdSentinel = errors.New("io failure")
+	)
+	tests := []struct {
+		name string
+		err  *gerror.Error
+		want error
+	}{
+		{"root", sentinel.(*gerror.Error), sentinel},
+		{"wrapped gerror", gerror.Wrap(gerror.Wrap(sentinel, "layer 1"), "layer 2").(*gerror.Error), sentinel},
+		{"wrapped standard error", gerror.Wrap(fmt.Errorf("layer 1: %w", stdSentinel), "layer 2").(*gerror.Error), stdSentinel},
+	}
+	for _, tt := range tests {
+		// The method and the package function agree on the root error.
+		if cause := tt.err.Cause(); cause != tt.want {
+			t.Errorf("%s: Error.Cause = %#v, want %#v", tt.name, cause, tt.want)
+		}
+		if cause := gerror.Cause(tt.err); cause != tt.want {
+			t.Errorf("%s: Cause = %#v, want %#v", tt.name, cause, tt.want)
+		}
+	}
+	var nilErr *gerror.Error
+	if nilErr.Cause() != nil {
+		t.Error("Cause of a nil *Error should be nil")
+	}
+}
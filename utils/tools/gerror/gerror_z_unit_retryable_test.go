@@ -0,0 +1,66 @@
+package gerror_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+)
+
+func TestIsRetryable(t *testing.T) {
+	var (
+		busy       = gerror.NewCode(gcode.CodeServerBusy, "busy")
+		validation = gerror.NewCode(gcode.CodeValidationFailed, "invalid")
+	)
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server busy", busy, true},
+		{"db operation error", gerror.NewCode(gcode.CodeDbOperationError, "db"), true},
+		{"validation failed", validation, false},
+		{"plain error", errors.New("plain"), false},
+		{"wrapped busy", gerror.Wrap(busy, "outer"), true},
+		{"std wrapped busy", fmt.Errorf("outer: %w", busy), true},
+		{"marked validation", gerror.MarkRetryable(validation), true},
+		{"wrapped marked", gerror.Wrap(gerror.MarkRetryable(errors.New("plain")), "outer"), true},
+	}
+	for _, tt := range tests {
+		if got := gerror.IsRetryable(tt.err); got != tt.want {
+			t.Errorf("%s: IsRetryable = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if gerror.MarkRetryable(nil) != nil {
+		t.Error("MarkRetryable(nil) should be nil")
+	}
+	marked := gerror.MarkRetryable(validation)
+	if marked.Error() != "invalid" {
+		t.Errorf("marked message = %q, want invalid", marked.Error())
+	}
+	if gerror.Code(marked) != gcode.CodeValidationFailed {
+		t.Errorf("marked code = %v, want CodeValidationFailed", gerror.Code(marked))
+	}
+}
+
+func TestRetryableCodes(t *testing.T) {
+	defer gerror.SetRetryableCodes(gcode.CodeServerBusy, gcode.CodeDbOperationError)
+
+	notFound := gerror.NewCode(gcode.CodeNotFound, "missing")
+	gerror.AddRetryableCodes(gcode.CodeNotFound)
+	if !gerror.IsRetryable(notFound) {
+		t.Error("added code should be retryable")
+	}
+
+	gerror.SetRetryableCodes(gcode.CodeNotFound)
+	if gerror.IsRetryable(gerror.NewCode(gcode.CodeServerBusy, "busy")) {
+		t.Error("SetRetryableCodes should replace the default set")
+	}
+	if !gerror.IsRetryable(notFound) {
+		t.Error("code in the replaced set should be retryable")
+	}
+}
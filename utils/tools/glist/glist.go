@@ -8,6 +8,7 @@ import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
+	"reflect"
 )
 
 type (
@@ -412,6 +413,91 @@ func (l *List) Clear() {
 	l.RemoveAll()
 }
 
+// Reverse 原地反转列表 `l` 中元素的顺序。
+// 元素本身不会被重新创建，只调整其在列表中的位置。
+func (l *List) Reverse() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.list == nil || l.list.Len() < 2 {
+		return
+	}
+	// 不断将末尾元素移动到原首元素之前，直到原首元素成为末尾元素。
+	mark := l.list.Front()
+	for e := l.list.Back(); e != mark; e = l.list.Back() {
+		l.list.MoveBefore(e, mark)
+	}
+}
+
+// Reversed 返回一个元素顺序与 `l` 相反的新列表，`l` 本身不会被修改。
+// 新列表的并发安全设置与 `l` 保持一致。
+func (l *List) Reversed() *List {
+	reversed := New(l.mu.IsSafe())
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.list == nil {
+		return reversed
+	}
+	for e := l.list.Front(); e != nil; e = e.Next() {
+		reversed.list.PushFront(e.Value)
+	}
+	return reversed
+}
+
+// Unique 返回一个去除了重复值的新列表，保留每个值第一次出现的位置和顺序，`l` 本身不会被修改。
+// 新列表的并发安全设置与 `l` 保持一致。
+//
+// 注意：去重基于 map 实现，值需要是可比较的类型；
+// 切片、映射、函数等不可比较类型的值不参与去重，会全部保留。
+func (l *List) Unique() *List {
+	unique := New(l.mu.IsSafe())
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.list == nil {
+		return unique
+	}
+	seen := make(map[interface{}]struct{}, l.list.Len())
+	for e := l.list.Front(); e != nil; e = e.Next() {
+		if isDuplicate(seen, e.Value) {
+			continue
+		}
+		unique.list.PushBack(e.Value)
+	}
+	return unique
+}
+
+// RemoveDuplicates 原地移除列表 `l` 中的重复值，保留每个值第一次出现的元素。
+// 也请参阅 Unique。
+func (l *List) RemoveDuplicates() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.list == nil || l.list.Len() < 2 {
+		return
+	}
+	var (
+		seen = make(map[interface{}]struct{}, l.list.Len())
+		next *Element
+	)
+	for e := l.list.Front(); e != nil; e = next {
+		next = e.Next()
+		if isDuplicate(seen, e.Value) {
+			l.list.Remove(e)
+		}
+	}
+}
+
+// isDuplicate 检查 `value` 是否已存在于 `seen` 中，不存在时将其加入 `seen`。
+// 不可比较类型的值总是返回 false。
+func isDuplicate(seen map[interface{}]struct{}, value interface{}) bool {
+	if value != nil && !reflect.TypeOf(value).Comparable() {
+		return false
+	}
+	if _, ok := seen[value]; ok {
+		return true
+	}
+	seen[value] = struct{}{}
+	return false
+}
+
 // RLockFunc 使用 RWMutex.RLock 内的给定回调函数 `f` 锁定读取。
 func (l *List) RLockFunc(f func(list *list.List)) {
 	l.mu.RLock()
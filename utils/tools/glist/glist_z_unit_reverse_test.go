@@ -0,0 +1,68 @@
+package glist_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/glist"
+)
+
+func TestList_Reverse(t *testing.T) {
+	tests := []struct {
+		values []interface{}
+		want   []interface{}
+	}{
+		{[]interface{}{1, 2, 3, 4, 5}, []interface{}{5, 4, 3, 2, 1}},
+		{[]interface{}{1, 2}, []interface{}{2, 1}},
+		{[]interface{}{1}, []interface{}{1}},
+		{[]interface{}{}, nil},
+	}
+	for _, tt := range tests {
+		l := glist.NewFrom(tt.values)
+		front := l.Front()
+		l.Reverse()
+		if got := l.FrontAll(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Reverse(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+		if front != nil && l.Back() != front {
+			t.Error("Reverse should move the existing elements, not recreate them")
+		}
+	}
+}
+
+func TestList_Reversed(t *testing.T) {
+	l := glist.NewFrom([]interface{}{1, 2, 3}, true)
+	reversed := l.Reversed()
+	if got, want := reversed.FrontAll(), []interface{}{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reversed = %v, want %v", got, want)
+	}
+	if got, want := l.FrontAll(), []interface{}{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("source = %v, want it unchanged %v", got, want)
+	}
+	if got := glist.New().Reversed().Len(); got != 0 {
+		t.Errorf("Reversed of an empty list has %d elements", got)
+	}
+}
+
+func TestList_Reverse_Concurrent(t *testing.T) {
+	var (
+		l  = glist.NewFrom([]interface{}{1, 2, 3, 4}, true)
+		wg sync.WaitGroup
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.Reverse()
+		}()
+		go func() {
+			defer wg.Done()
+			_ = l.Reversed()
+		}()
+	}
+	wg.Wait()
+	if got, want := l.FrontAll(), []interface{}{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after an even number of reverses = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package glist_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/glist"
+)
+
+func TestList_Unique(t *testing.T) {
+	tests := []struct {
+		values []interface{}
+		want   []interface{}
+	}{
+		{[]interface{}{1, 2, 1, 3, 2, 4, 1}, []interface{}{1, 2, 3, 4}},
+		{[]interface{}{"b", "a", "b", nil, "a", nil}, []interface{}{"b", "a", nil}},
+		{[]interface{}{1, int64(1), "1"}, []interface{}{1, int64(1), "1"}},
+		{[]interface{}{[]int{1}, []int{1}, 2, 2}, []interface{}{[]int{1}, []int{1}, 2}},
+		{[]interface{}{1, 2, 3}, []interface{}{1, 2, 3}},
+		{[]interface{}{}, nil},
+	}
+	for _, tt := range tests {
+		var (
+			l    = glist.NewFrom(tt.values)
+			want = l.FrontAll()
+		)
+		if got := l.Unique().FrontAll(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Unique(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+		if got := l.FrontAll(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Unique modified the source list to %v", got)
+		}
+
+		l.RemoveDuplicates()
+		if got := l.FrontAll(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RemoveDuplicates(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestList_Unique_Concurrent(t *testing.T) {
+	var (
+		l  = glist.NewFrom([]interface{}{1, 1, 2}, true)
+		u  = l.Unique()
+		wg sync.WaitGroup
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			u.PushBack(i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			l.RemoveDuplicates()
+			_ = u.Len()
+		}()
+	}
+	wg.Wait()
+	if u.Len() != 12 {
+		t.Errorf("Len = %d, want 12", u.Len())
+	}
+}
@@ -37,3 +37,19 @@ func NewHashMap(safe ...bool) *Map {
 func NewHashMapFrom(data map[interface{}]interface{}, safe ...bool) *Map {
 	return NewAnyAnyMapFrom(data, safe...)
 }
+
+// GroupBy 按 `keyFunc` 返回的键对 `items` 进行分组，返回的映射中每个值为 []interface{} 类型，
+// 包含所有键相同的项，且保持它们在 `items` 中的原始顺序。
+// 参数“安全”用于指定返回的映射是否并发安全，默认情况下是false。
+func GroupBy(items []interface{}, keyFunc func(item interface{}) interface{}, safe ...bool) *AnyAnyMap {
+	groups := make(map[interface{}]interface{})
+	for _, item := range items {
+		key := keyFunc(item)
+		if group, ok := groups[key]; ok {
+			groups[key] = append(group.([]interface{}), item)
+		} else {
+			groups[key] = []interface{}{item}
+		}
+	}
+	return NewAnyAnyMapFrom(groups, safe...)
+}
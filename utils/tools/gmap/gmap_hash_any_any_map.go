@@ -1,6 +1,7 @@
 package gmap
 
 import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
@@ -44,6 +45,20 @@ func (m *AnyAnyMap) Iterator(f func(k interface{}, v interface{}) bool) {
 	}
 }
 
+// IteratorRLocked 在读锁下直接迭代哈希映射，不会复制底层数据，适合只读回调遍历大映射的场景。
+// 如果 `f` 返回 true，则继续迭代；返回 false 则停止。
+//
+// 注意：回调 `f` 中不可再调用当前映射的任何方法，否则可能导致死锁。
+func (m *AnyAnyMap) IteratorRLocked(f func(k interface{}, v interface{}) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for k, v := range m.data {
+		if !f(k, v) {
+			break
+		}
+	}
+}
+
 // Clone 返回一个包含当前映射数据副本的新哈希映射。
 func (m *AnyAnyMap) Clone(safe ...bool) *AnyAnyMap {
 	return NewFrom(m.MapCopy(), safe...)
@@ -331,6 +346,27 @@ func (m *AnyAnyMap) Removes(keys []interface{}) {
 	m.mu.Unlock()
 }
 
+// Compute 在写锁下使用 `key` 的当前值调用 `f`，用于原子地完成“读取-修改-写入”操作。
+// `f` 的参数 `exists` 表示 `key` 是否存在；若 `f` 返回的 `delete` 为 true 则删除 `key`，
+// 否则将 `key` 的值设置为 `newVal`。它返回 `key` 最终的值，删除时返回 nil。
+//
+// 注意：`f` 中不可再调用当前映射的任何方法，否则会导致死锁。
+func (m *AnyAnyMap) Compute(key interface{}, f func(old interface{}, exists bool) (newVal interface{}, delete bool)) (value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.data == nil {
+		m.data = make(map[interface{}]interface{})
+	}
+	old, exists := m.data[key]
+	newVal, del := f(old, exists)
+	if del {
+		delete(m.data, key)
+		return nil
+	}
+	m.data[key] = newVal
+	return newVal
+}
+
 // Keys 以切片形式返回映射的所有键。
 func (m *AnyAnyMap) Keys() []interface{} {
 	m.mu.RLock()
@@ -444,6 +480,38 @@ func (m *AnyAnyMap) Merge(other *AnyAnyMap) {
 	}
 }
 
+// Pick 返回一个只包含给定 `keys` 的新映射，不存在的键会被忽略。
+// 当前映射不会被修改，新映射的并发安全设置与当前映射保持一致。
+func (m *AnyAnyMap) Pick(keys ...interface{}) *AnyAnyMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[interface{}]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := m.data[key]; ok {
+			data[key] = value
+		}
+	}
+	return NewAnyAnyMapFrom(data, m.mu.IsSafe())
+}
+
+// Omit 返回一个排除了给定 `keys` 的新映射。
+// 当前映射不会被修改，新映射的并发安全设置与当前映射保持一致。
+func (m *AnyAnyMap) Omit(keys ...interface{}) *AnyAnyMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	omitted := make(map[interface{}]struct{}, len(keys))
+	for _, key := range keys {
+		omitted[key] = struct{}{}
+	}
+	data := make(map[interface{}]interface{}, len(m.data))
+	for key, value := range m.data {
+		if _, ok := omitted[key]; !ok {
+			data[key] = value
+		}
+	}
+	return NewAnyAnyMapFrom(data, m.mu.IsSafe())
+}
+
 // String 将映射作为字符串返回。
 func (m *AnyAnyMap) String() string {
 	if m == nil {
@@ -488,6 +556,21 @@ func (m *AnyAnyMap) UnmarshalValue(value interface{}) (err error) {
 	return
 }
 
+// DeepCopy 实现当前类型的深拷贝接口。
+// 键直接复制，值通过 deepcopy.Copy 递归深拷贝，实现了 deepcopy.Interface 的值会使用其自身的 DeepCopy。
+func (m *AnyAnyMap) DeepCopy() interface{} {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[interface{}]interface{}, len(m.data))
+	for k, v := range m.data {
+		data[k] = deepcopy.Copy(v)
+	}
+	return NewAnyAnyMapFrom(data, m.mu.IsSafe())
+}
+
 // IsSubOf 检查当前映射是否是 `other` 映射的子映射。
 func (m *AnyAnyMap) IsSubOf(other *AnyAnyMap) bool {
 	if m == other {
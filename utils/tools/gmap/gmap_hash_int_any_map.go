@@ -1,12 +1,14 @@
 package gmap
 
 import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
 	"reflect"
+	"sort"
 )
 
 // IntAnyMap 实现了带有 switch 的 RWMutex 的 map[int]interface{}。
@@ -47,6 +49,22 @@ func (m *IntAnyMap) Iterator(f func(k int, v interface{}) bool) {
 	}
 }
 
+// IteratorSorted 按键的数值升序遍历映射，只读模式，适用于需要确定性输出的场景。
+// 如果 `f` 返回 true，则继续迭代；如果返回 false，则停止迭代。
+func (m *IntAnyMap) IteratorSorted(f func(k int, v interface{}) bool) {
+	data := m.Map()
+	keys := make([]int, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	for _, k := range keys {
+		if !f(k, data[k]) {
+			break
+		}
+	}
+}
+
 // Clone 返回一个新的哈希映射，其中包含当前映射数据的副本。
 func (m *IntAnyMap) Clone() *IntAnyMap {
 	return NewIntAnyMapFrom(m.MapCopy(), m.mu.IsSafe())
@@ -338,6 +356,27 @@ func (m *IntAnyMap) Remove(key int) (value interface{}) {
 	return
 }
 
+// Compute 在写锁下使用 `key` 的当前值调用 `f`，用于原子地完成“读取-修改-写入”操作。
+// `f` 的参数 `exists` 表示 `key` 是否存在；若 `f` 返回的 `delete` 为 true 则删除 `key`，
+// 否则将 `key` 的值设置为 `newVal`。它返回 `key` 最终的值，删除时返回 nil。
+//
+// 注意：`f` 中不可再调用当前映射的任何方法，否则会导致死锁。
+func (m *IntAnyMap) Compute(key int, f func(old interface{}, exists bool) (newVal interface{}, delete bool)) (value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.data == nil {
+		m.data = make(map[int]interface{})
+	}
+	old, exists := m.data[key]
+	newVal, del := f(old, exists)
+	if del {
+		delete(m.data, key)
+		return nil
+	}
+	m.data[key] = newVal
+	return newVal
+}
+
 // Keys 返回哈希映射中所有键的切片。
 func (m *IntAnyMap) Keys() []int {
 	m.mu.RLock()
@@ -353,6 +392,13 @@ func (m *IntAnyMap) Keys() []int {
 	return keys
 }
 
+// SortedKeys 以切片形式返回映射的所有键，按数值升序排列。
+func (m *IntAnyMap) SortedKeys() []int {
+	keys := m.Keys()
+	sort.Ints(keys)
+	return keys
+}
+
 // Values 返回哈希映射中所有值的切片。
 func (m *IntAnyMap) Values() []interface{} {
 	m.mu.RLock()
@@ -451,6 +497,38 @@ func (m *IntAnyMap) Merge(other *IntAnyMap) {
 	}
 }
 
+// Pick 返回一个只包含给定 `keys` 的新映射，不存在的键会被忽略。
+// 当前映射不会被修改，新映射的并发安全设置与当前映射保持一致。
+func (m *IntAnyMap) Pick(keys ...int) *IntAnyMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[int]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := m.data[key]; ok {
+			data[key] = value
+		}
+	}
+	return NewIntAnyMapFrom(data, m.mu.IsSafe())
+}
+
+// Omit 返回一个排除了给定 `keys` 的新映射。
+// 当前映射不会被修改，新映射的并发安全设置与当前映射保持一致。
+func (m *IntAnyMap) Omit(keys ...int) *IntAnyMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	omitted := make(map[int]struct{}, len(keys))
+	for _, key := range keys {
+		omitted[key] = struct{}{}
+	}
+	data := make(map[int]interface{}, len(m.data))
+	for key, value := range m.data {
+		if _, ok := omitted[key]; !ok {
+			data[key] = value
+		}
+	}
+	return NewIntAnyMapFrom(data, m.mu.IsSafe())
+}
+
 // String 返回哈希映射的字符串表示形式。
 func (m *IntAnyMap) String() string {
 	if m == nil {
@@ -498,6 +576,21 @@ func (m *IntAnyMap) UnmarshalValue(value interface{}) (err error) {
 	return
 }
 
+// DeepCopy 实现当前类型的深拷贝接口。
+// 键直接复制，值通过 deepcopy.Copy 递归深拷贝，实现了 deepcopy.Interface 的值会使用其自身的 DeepCopy。
+func (m *IntAnyMap) DeepCopy() interface{} {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[int]interface{}, len(m.data))
+	for k, v := range m.data {
+		data[k] = deepcopy.Copy(v)
+	}
+	return NewIntAnyMapFrom(data, m.mu.IsSafe())
+}
+
 // IsSubOf 检查当前哈希映射是否是 `other` 哈希映射的子映射。
 // 如果当前哈希映射中的所有键值对都存在于 `other` 哈希映射中，且对应的值相等，则返回 true，否则返回 false。
 func (m *IntAnyMap) IsSubOf(other *IntAnyMap) bool {
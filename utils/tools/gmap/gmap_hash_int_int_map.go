@@ -5,6 +5,7 @@ import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
+	"sort"
 )
 
 // IntIntMap 实现了带有 RWMutex 开关的 map[int]int。
@@ -42,6 +43,22 @@ func (m *IntIntMap) Iterator(f func(k int, v int) bool) {
 	}
 }
 
+// IteratorSorted 按键的数值升序遍历映射，只读模式，适用于需要确定性输出的场景。
+// 如果 `f` 返回 true，则继续迭代；如果返回 false，则停止迭代。
+func (m *IntIntMap) IteratorSorted(f func(k int, v int) bool) {
+	data := m.Map()
+	keys := make([]int, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	for _, k := range keys {
+		if !f(k, data[k]) {
+			break
+		}
+	}
+}
+
 // Clone 返回一个包含当前映射数据副本的新哈希映射。
 func (m *IntIntMap) Clone() *IntIntMap {
 	return NewIntIntMapFrom(m.MapCopy(), m.mu.IsSafe())
@@ -317,6 +334,13 @@ func (m *IntIntMap) Keys() []int {
 	return keys
 }
 
+// SortedKeys 以切片形式返回映射的所有键，按数值升序排列。
+func (m *IntIntMap) SortedKeys() []int {
+	keys := m.Keys()
+	sort.Ints(keys)
+	return keys
+}
+
 // Values 以切片形式返回映射的所有值。
 func (m *IntIntMap) Values() []int {
 	m.mu.RLock()
@@ -1,12 +1,14 @@
 package gmap
 
 import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gvar"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
 	"reflect"
+	"sort"
 )
 
 // StrAnyMap 实现了带有RWMutex读写锁开关的 map[string]interface{}。
@@ -44,6 +46,22 @@ func (m *StrAnyMap) Iterator(f func(k string, v interface{}) bool) {
 	}
 }
 
+// IteratorSorted 按键的字典序升序遍历映射，只读模式，适用于需要确定性输出的场景。
+// 如果 `f` 返回 true，则继续迭代；如果返回 false，则停止迭代。
+func (m *StrAnyMap) IteratorSorted(f func(k string, v interface{}) bool) {
+	data := m.Map()
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !f(k, data[k]) {
+			break
+		}
+	}
+}
+
 // Clone 返回一个包含当前映射数据副本的新哈希映射。
 func (m *StrAnyMap) Clone() *StrAnyMap {
 	return NewStrAnyMapFrom(m.MapCopy(), m.mu.IsSafe())
@@ -319,6 +337,27 @@ func (m *StrAnyMap) Remove(key string) (value interface{}) {
 	return
 }
 
+// Compute 在写锁下使用 `key` 的当前值调用 `f`，用于原子地完成“读取-修改-写入”操作。
+// `f` 的参数 `exists` 表示 `key` 是否存在；若 `f` 返回的 `delete` 为 true 则删除 `key`，
+// 否则将 `key` 的值设置为 `newVal`。它返回 `key` 最终的值，删除时返回 nil。
+//
+// 注意：`f` 中不可再调用当前映射的任何方法，否则会导致死锁。
+func (m *StrAnyMap) Compute(key string, f func(old interface{}, exists bool) (newVal interface{}, delete bool)) (value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.data == nil {
+		m.data = make(map[string]interface{})
+	}
+	old, exists := m.data[key]
+	newVal, del := f(old, exists)
+	if del {
+		delete(m.data, key)
+		return nil
+	}
+	m.data[key] = newVal
+	return newVal
+}
+
 // Keys 以切片形式返回映射的所有键。
 func (m *StrAnyMap) Keys() []string {
 	m.mu.RLock()
@@ -334,6 +373,13 @@ func (m *StrAnyMap) Keys() []string {
 	return keys
 }
 
+// SortedKeys 以切片形式返回映射的所有键，按字典序升序排列。
+func (m *StrAnyMap) SortedKeys() []string {
+	keys := m.Keys()
+	sort.Strings(keys)
+	return keys
+}
+
 // Values 以切片形式返回映射的所有值。
 func (m *StrAnyMap) Values() []interface{} {
 	m.mu.RLock()
@@ -432,6 +478,38 @@ func (m *StrAnyMap) Merge(other *StrAnyMap) {
 	}
 }
 
+// Pick 返回一个只包含给定 `keys` 的新映射，不存在的键会被忽略。
+// 当前映射不会被修改，新映射的并发安全设置与当前映射保持一致。
+func (m *StrAnyMap) Pick(keys ...string) *StrAnyMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := m.data[key]; ok {
+			data[key] = value
+		}
+	}
+	return NewStrAnyMapFrom(data, m.mu.IsSafe())
+}
+
+// Omit 返回一个排除了给定 `keys` 的新映射。
+// 当前映射不会被修改，新映射的并发安全设置与当前映射保持一致。
+func (m *StrAnyMap) Omit(keys ...string) *StrAnyMap {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	omitted := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		omitted[key] = struct{}{}
+	}
+	data := make(map[string]interface{}, len(m.data))
+	for key, value := range m.data {
+		if _, ok := omitted[key]; !ok {
+			data[key] = value
+		}
+	}
+	return NewStrAnyMapFrom(data, m.mu.IsSafe())
+}
+
 // String 将映射作为字符串返回。
 func (m *StrAnyMap) String() string {
 	if m == nil {
@@ -469,6 +547,29 @@ func (m *StrAnyMap) UnmarshalValue(value interface{}) (err error) {
 	return
 }
 
+// Struct 将映射中的数据绑定到结构体指针 `pointer`，通常用于将配置映射转换为强类型结构体。
+// 键优先按字段的 json 等标签匹配，其次按字段名匹配，并支持忽略大小写和下划线等符号的模糊匹配；
+// 值会通过 gconv 转换为字段的类型（如字符串 "1" 转换为 int），值为映射时会递归绑定到嵌套结构体。
+// 没有匹配到的字段保持其零值，多余的键会被忽略。
+func (m *StrAnyMap) Struct(pointer interface{}) error {
+	return gconv.Struct(m.MapCopy(), pointer)
+}
+
+// DeepCopy 实现当前类型的深拷贝接口。
+// 键直接复制，值通过 deepcopy.Copy 递归深拷贝，实现了 deepcopy.Interface 的值会使用其自身的 DeepCopy。
+func (m *StrAnyMap) DeepCopy() interface{} {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[string]interface{}, len(m.data))
+	for k, v := range m.data {
+		data[k] = deepcopy.Copy(v)
+	}
+	return NewStrAnyMapFrom(data, m.mu.IsSafe())
+}
+
 // IsSubOf 检查当前映射是否是 `other` 映射的子映射。
 func (m *StrAnyMap) IsSubOf(other *StrAnyMap) bool {
 	if m == other {
@@ -22,6 +22,7 @@ type ListMap struct {
 	mu   rwmutex.RWMutex
 	data map[interface{}]*glist.Element
 	list *glist.List
+	cap  int // cap 是映射的容量上限，<= 0 表示不限制。
 }
 
 type gListMapNode struct {
@@ -41,6 +42,16 @@ func NewListMap(safe ...bool) *ListMap {
 	}
 }
 
+// NewListMapWithCap 返回一个容量上限为 `cap` 的空链表映射。
+// 当设置新键导致大小超过 `cap` 时，最早插入的键值对会被淘汰；
+// 重新设置已存在的键会将其移动到链表末尾，从而刷新其新近度。
+// 参数 `safe` 用于指定是否在并发安全的情况下使用映射，默认为 false。
+func NewListMapWithCap(cap int, safe ...bool) *ListMap {
+	m := NewListMap(safe...)
+	m.cap = cap
+	return m
+}
+
 // NewListMapFrom 从给定的映射 `data` 返回一个链表映射。
 // 注意，参数 `data` 映射将被设置为底层数据映射（没有深拷贝），
 // 在改变外部映射时可能存在一些并发安全问题。
@@ -83,9 +94,20 @@ func (m *ListMap) IteratorDesc(f func(key interface{}, value interface{}) bool)
 	}
 }
 
-// Clone 返回一个带有当前映射数据副本的新链表映射。
+// Clone 返回一个带有当前映射数据副本的新链表映射，保留键的顺序和容量上限。
 func (m *ListMap) Clone(safe ...bool) *ListMap {
-	return NewListMapFrom(m.Map(), safe...)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n := NewListMapWithCap(m.cap, safe...)
+	if m.list != nil {
+		var node *gListMapNode
+		m.list.IteratorAsc(func(e *glist.Element) bool {
+			node = e.Value.(*gListMapNode)
+			n.data[node.key] = n.list.PushBack(&gListMapNode{node.key, node.value})
+			return true
+		})
+	}
+	return n
 }
 
 // Clear 删除映射的所有数据，它将重新创建一个新的底层数据映射。
@@ -102,12 +124,9 @@ func (m *ListMap) Replace(data map[interface{}]interface{}) {
 	m.data = make(map[interface{}]*glist.Element)
 	m.list = glist.New()
 	for key, value := range data {
-		if e, ok := m.data[key]; !ok {
-			m.data[key] = m.list.PushBack(&gListMapNode{key, value})
-		} else {
-			e.Value = &gListMapNode{key, value}
-		}
+		m.doSet(key, value)
 	}
+	m.doEvict()
 	m.mu.Unlock()
 }
 
@@ -174,17 +193,46 @@ func (m *ListMap) FilterEmpty() {
 
 // Set 设置键值到映射。
 func (m *ListMap) Set(key interface{}, value interface{}) {
+	m.SetWithEvict(key, value)
+}
+
+// SetWithEvict 设置键值到映射，如果映射设置了容量上限且大小超出上限，
+// 则淘汰最早插入的键值对，并返回被淘汰的键和值，`evicted` 表示是否发生了淘汰。
+func (m *ListMap) SetWithEvict(key interface{}, value interface{}) (evictedKey, evictedValue interface{}, evicted bool) {
 	m.mu.Lock()
+	defer m.mu.Unlock()
 	if m.data == nil {
 		m.data = make(map[interface{}]*glist.Element)
 		m.list = glist.New()
 	}
+	m.doSet(key, value)
+	return m.doEvict()
+}
+
+// doSet 设置键值到映射，调用方需持有写锁。
+// 如果映射设置了容量上限，重新设置已存在的键会将其移动到链表末尾，从而刷新其新近度。
+func (m *ListMap) doSet(key interface{}, value interface{}) {
 	if e, ok := m.data[key]; !ok {
 		m.data[key] = m.list.PushBack(&gListMapNode{key, value})
 	} else {
 		e.Value = &gListMapNode{key, value}
+		if m.cap > 0 {
+			m.list.MoveToBack(e)
+		}
 	}
-	m.mu.Unlock()
+}
+
+// doEvict 在映射大小超过容量上限时淘汰最早插入的键值对，调用方需持有写锁。
+func (m *ListMap) doEvict() (evictedKey, evictedValue interface{}, evicted bool) {
+	if m.cap <= 0 || len(m.data) <= m.cap {
+		return nil, nil, false
+	}
+	for len(m.data) > m.cap {
+		node := m.list.Remove(m.list.Front()).(*gListMapNode)
+		delete(m.data, node.key)
+		evictedKey, evictedValue, evicted = node.key, node.value, true
+	}
+	return
 }
 
 // Sets 批量设置键值到映射。
@@ -195,12 +243,9 @@ func (m *ListMap) Sets(data map[interface{}]interface{}) {
 		m.list = glist.New()
 	}
 	for key, value := range data {
-		if e, ok := m.data[key]; !ok {
-			m.data[key] = m.list.PushBack(&gListMapNode{key, value})
-		} else {
-			e.Value = &gListMapNode{key, value}
-		}
+		m.doSet(key, value)
 	}
+	m.doEvict()
 	m.mu.Unlock()
 }
 
@@ -286,6 +331,9 @@ func (m *ListMap) doSetWithLockCheck(key interface{}, value interface{}) interfa
 		m.list = glist.New()
 	}
 	if e, ok := m.data[key]; ok {
+		if m.cap > 0 {
+			m.list.MoveToBack(e)
+		}
 		return e.Value.(*gListMapNode).value
 	}
 	if f, ok := value.(func() interface{}); ok {
@@ -293,6 +341,7 @@ func (m *ListMap) doSetWithLockCheck(key interface{}, value interface{}) interfa
 	}
 	if value != nil {
 		m.data[key] = m.list.PushBack(&gListMapNode{key, value})
+		m.doEvict()
 	}
 	return value
 }
@@ -490,26 +539,24 @@ func (m *ListMap) Flip() {
 // Merge 合并两个链表映射。
 // `other` 映射将被合并到映射 `m` 中。
 func (m *ListMap) Merge(other *ListMap) {
+	if other == m {
+		return
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	if m.data == nil {
 		m.data = make(map[interface{}]*glist.Element)
 		m.list = glist.New()
 	}
-	if other != m {
-		other.mu.RLock()
-		defer other.mu.RUnlock()
-	}
+	other.mu.RLock()
+	defer other.mu.RUnlock()
 	var node *gListMapNode
 	other.list.IteratorAsc(func(e *glist.Element) bool {
 		node = e.Value.(*gListMapNode)
-		if e, ok := m.data[node.key]; !ok {
-			m.data[node.key] = m.list.PushBack(&gListMapNode{node.key, node.value})
-		} else {
-			e.Value = &gListMapNode{node.key, node.value}
-		}
+		m.doSet(node.key, node.value)
 		return true
 	})
+	m.doEvict()
 }
 
 // String 返回映射作为字符串。
@@ -557,12 +604,9 @@ func (m *ListMap) UnmarshalJSON(b []byte) error {
 		return err
 	}
 	for key, value := range data {
-		if e, ok := m.data[key]; !ok {
-			m.data[key] = m.list.PushBack(&gListMapNode{key, value})
-		} else {
-			e.Value = &gListMapNode{key, value}
-		}
+		m.doSet(key, value)
 	}
+	m.doEvict()
 	return nil
 }
 
@@ -575,11 +619,8 @@ func (m *ListMap) UnmarshalValue(value interface{}) (err error) {
 		m.list = glist.New()
 	}
 	for k, v := range gconv.Map(value) {
-		if e, ok := m.data[k]; !ok {
-			m.data[k] = m.list.PushBack(&gListMapNode{k, v})
-		} else {
-			e.Value = &gListMapNode{k, v}
-		}
+		m.doSet(k, v)
 	}
+	m.doEvict()
 	return
 }
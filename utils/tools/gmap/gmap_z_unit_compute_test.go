@@ -0,0 +1,77 @@
+package gmap_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestAnyAnyMap_Compute_Concurrent(t *testing.T) {
+	var (
+		m  = gmap.NewAnyAnyMap(true)
+		wg sync.WaitGroup
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Compute("counter", func(old interface{}, exists bool) (interface{}, bool) {
+					if !exists {
+						return 1, false
+					}
+					return old.(int) + 1, false
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	if got := m.Get("counter"); got != 5000 {
+		t.Errorf("counter = %v, want 5000", got)
+	}
+}
+
+func TestStrAnyMap_Compute(t *testing.T) {
+	m := gmap.NewStrAnyMap(true)
+
+	v := m.Compute("k", func(old interface{}, exists bool) (interface{}, bool) {
+		if exists {
+			t.Error("exists should be false for a missing key")
+		}
+		return "created", false
+	})
+	if v != "created" || m.Get("k") != "created" {
+		t.Errorf("Compute on a missing key = %v, stored %v", v, m.Get("k"))
+	}
+
+	v = m.Compute("k", func(old interface{}, exists bool) (interface{}, bool) {
+		return nil, true
+	})
+	if v != nil || m.Contains("k") {
+		t.Errorf("Compute with delete = %v, contains = %v", v, m.Contains("k"))
+	}
+}
+
+func TestIntAnyMap_Compute_Concurrent(t *testing.T) {
+	var (
+		m  = gmap.NewIntAnyMap(true)
+		wg sync.WaitGroup
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(delta int) {
+			defer wg.Done()
+			m.Compute(1, func(old interface{}, exists bool) (interface{}, bool) {
+				if !exists {
+					return delta, false
+				}
+				return old.(int) + delta, false
+			})
+		}(i)
+	}
+	wg.Wait()
+	if got := m.Get(1); got != 190 {
+		t.Errorf("sum = %v, want 190", got)
+	}
+}
@@ -0,0 +1,72 @@
+package gmap_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/deepcopy"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestAnyAnyMap_DeepCopy(t *testing.T) {
+	var (
+		inner = gmap.NewStrStrMapFrom(map[string]string{"k": "v"})
+		m     = gmap.NewAnyAnyMapFrom(map[interface{}]interface{}{
+			1:     map[string]interface{}{"name": "john"},
+			"tag": []string{"a", "b"},
+			"map": inner,
+		})
+		c = m.DeepCopy().(*gmap.AnyAnyMap)
+	)
+	c.Get(1).(map[string]interface{})["name"] = "jane"
+	c.Get("tag").([]string)[0] = "z"
+	c.Get("map").(*gmap.StrStrMap).Set("k", "changed")
+
+	if got := m.Get(1).(map[string]interface{})["name"]; got != "john" {
+		t.Errorf("nested map value = %v, want john", got)
+	}
+	if got := m.Get("tag"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("nested slice value = %v, want [a b]", got)
+	}
+	if got := inner.Get("k"); got != "v" {
+		t.Errorf("nested deepcopy.Interface value = %v, want v", got)
+	}
+}
+
+func TestStrAnyMap_DeepCopy(t *testing.T) {
+	m := gmap.NewStrAnyMapFrom(map[string]interface{}{
+		"user": map[string]interface{}{"roles": []int{1, 2}},
+	})
+	c := m.DeepCopy().(*gmap.StrAnyMap)
+	c.Get("user").(map[string]interface{})["roles"].([]int)[0] = 9
+	if got := m.Get("user").(map[string]interface{})["roles"]; !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("nested value = %v, want [1 2]", got)
+	}
+
+	// The unsafe copy exposes its underlying data by Map, the safe one returns a copy.
+	c.Map()["new"] = 1
+	if !c.Contains("new") {
+		t.Error("copy of an unsafe map should stay unsafe")
+	}
+	safe := gmap.NewStrAnyMapFrom(map[string]interface{}{}, true).DeepCopy().(*gmap.StrAnyMap)
+	safe.Map()["new"] = 1
+	if safe.Contains("new") {
+		t.Error("copy of a safe map should stay safe")
+	}
+}
+
+func TestIntAnyMap_DeepCopy(t *testing.T) {
+	m := gmap.NewIntAnyMapFrom(map[int]interface{}{
+		1: []interface{}{map[string]int{"n": 1}},
+	})
+	c := deepcopy.Copy(m).(*gmap.IntAnyMap)
+	c.Get(1).([]interface{})[0].(map[string]int)["n"] = 2
+	if got := m.Get(1).([]interface{})[0].(map[string]int)["n"]; got != 1 {
+		t.Errorf("nested value = %v, want 1", got)
+	}
+
+	var nilMap *gmap.IntAnyMap
+	if got := nilMap.DeepCopy(); got != nil {
+		t.Errorf("DeepCopy of nil map = %v, want nil", got)
+	}
+}
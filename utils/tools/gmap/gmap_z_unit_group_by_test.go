@@ -0,0 +1,43 @@
+package gmap_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestGroupBy(t *testing.T) {
+	type order struct {
+		Id   int
+		Dept string
+	}
+	items := []interface{}{
+		order{1, "sales"},
+		order{2, "ops"},
+		order{3, "sales"},
+		order{4, "hr"},
+		order{5, "sales"},
+		order{6, "ops"},
+	}
+	groups := gmap.GroupBy(items, func(item interface{}) interface{} {
+		return item.(order).Dept
+	})
+	if groups.Size() != 3 {
+		t.Errorf("Size = %d, want 3", groups.Size())
+	}
+	want := map[string][]interface{}{
+		"sales": {order{1, "sales"}, order{3, "sales"}, order{5, "sales"}},
+		"ops":   {order{2, "ops"}, order{6, "ops"}},
+		"hr":    {order{4, "hr"}},
+	}
+	for dept, group := range want {
+		if got := groups.Get(dept); !reflect.DeepEqual(got, group) {
+			t.Errorf("group %q = %v, want %v", dept, got, group)
+		}
+	}
+
+	if empty := gmap.GroupBy(nil, func(item interface{}) interface{} { return item }); !empty.IsEmpty() {
+		t.Errorf("GroupBy(nil) = %v, want empty", empty.Map())
+	}
+}
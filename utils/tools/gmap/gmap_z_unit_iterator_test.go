@@ -0,0 +1,76 @@
+package gmap_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestAnyAnyMap_IteratorRLocked(t *testing.T) {
+	m := gmap.NewAnyAnyMap(true)
+	for i := 0; i < 100; i++ {
+		m.Set(i, i*2)
+	}
+
+	seen := make(map[interface{}]interface{})
+	m.IteratorRLocked(func(k, v interface{}) bool {
+		seen[k] = v
+		return true
+	})
+	if len(seen) != 100 {
+		t.Fatalf("visited %d entries, want 100", len(seen))
+	}
+	for k, v := range seen {
+		if v != k.(int)*2 {
+			t.Errorf("entry %v = %v, want %v", k, v, k.(int)*2)
+		}
+	}
+
+	count := 0
+	m.IteratorRLocked(func(k, v interface{}) bool {
+		count++
+		return count < 10
+	})
+	if count != 10 {
+		t.Errorf("visited %d entries after stopping, want 10", count)
+	}
+
+	// Concurrent readers are allowed while iterating.
+	m.IteratorRLocked(func(k, v interface{}) bool {
+		done := make(chan struct{})
+		go func() {
+			_ = m.Get(k)
+			close(done)
+		}()
+		<-done
+		return false
+	})
+}
+
+func benchmarkMap(size int) *gmap.AnyAnyMap {
+	m := gmap.NewAnyAnyMap(true)
+	for i := 0; i < size; i++ {
+		m.Set(i, i)
+	}
+	return m
+}
+
+func BenchmarkAnyAnyMap_Iterator(b *testing.B) {
+	m := benchmarkMap(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Iterator(func(k, v interface{}) bool {
+			return true
+		})
+	}
+}
+
+func BenchmarkAnyAnyMap_IteratorRLocked(b *testing.B) {
+	m := benchmarkMap(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.IteratorRLocked(func(k, v interface{}) bool {
+			return true
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gmap_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestListMap_WithCap_Evict(t *testing.T) {
+	m := gmap.NewListMapWithCap(3)
+	var evicted []interface{}
+	for i := 1; i <= 6; i++ {
+		if k, v, ok := m.SetWithEvict(i, i*10); ok {
+			if v != k.(int)*10 {
+				t.Errorf("evicted value = %v for key %v", v, k)
+			}
+			evicted = append(evicted, k)
+		}
+	}
+	if !reflect.DeepEqual(evicted, []interface{}{1, 2, 3}) {
+		t.Errorf("evicted = %v, want [1 2 3]", evicted)
+	}
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []interface{}{4, 5, 6}) {
+		t.Errorf("Keys = %v, want [4 5 6]", keys)
+	}
+}
+
+func TestListMap_WithCap_Refresh(t *testing.T) {
+	m := gmap.NewListMapWithCap(2)
+	m.Set(1, 1)
+	m.Set(2, 2)
+	m.Set(1, 11)
+	m.Set(3, 3)
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []interface{}{1, 3}) {
+		t.Errorf("Keys after Set = %v, want [1 3]", keys)
+	}
+
+	m = gmap.NewListMapWithCap(2)
+	m.Set(1, 1)
+	m.Set(2, 2)
+	m.Sets(map[interface{}]interface{}{1: 11, 3: 3})
+	if m.Size() != 2 || !m.Contains(1) || !m.Contains(3) || m.Get(1) != 11 {
+		t.Errorf("map after Sets = %v, want keys 1 and 3", m.Map())
+	}
+}
+
+func TestListMap_WithCap_GetOrSet(t *testing.T) {
+	m := gmap.NewListMapWithCap(2)
+	m.Set(1, 1)
+	m.Set(2, 2)
+	if v := m.GetOrSet(3, 3); v != 3 {
+		t.Errorf("GetOrSet = %v, want 3", v)
+	}
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []interface{}{2, 3}) {
+		t.Errorf("Keys = %v, want [2 3]", keys)
+	}
+}
+
+func TestListMap_WithCap_Clone(t *testing.T) {
+	m := gmap.NewListMapWithCap(2)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	c := m.Clone()
+	if keys := c.Keys(); !reflect.DeepEqual(keys, []interface{}{"a", "b"}) {
+		t.Errorf("clone Keys = %v, want [a b]", keys)
+	}
+	c.Set("c", 3)
+	if keys := c.Keys(); !reflect.DeepEqual(keys, []interface{}{"b", "c"}) {
+		t.Errorf("clone Keys = %v, want [b c]", keys)
+	}
+	if m.Size() != 2 || !m.Contains("a") {
+		t.Errorf("original changed: %v", m.Map())
+	}
+}
+
+func TestListMap_WithoutCap(t *testing.T) {
+	m := gmap.NewListMap()
+	for i := 0; i < 100; i++ {
+		if _, _, ok := m.SetWithEvict(i, i); ok {
+			t.Fatal("unexpected eviction without cap")
+		}
+	}
+	// Without cap, re-setting a key keeps its position.
+	m.Set(0, 0)
+	if keys := m.Keys(); keys[0] != 0 || m.Size() != 100 {
+		t.Errorf("Keys[0] = %v, Size = %d", keys[0], m.Size())
+	}
+}
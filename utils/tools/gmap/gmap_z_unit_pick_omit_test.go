@@ -0,0 +1,60 @@
+package gmap_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestAnyAnyMap_PickOmit(t *testing.T) {
+	m := gmap.NewAnyAnyMapFrom(map[interface{}]interface{}{1: "a", 2: "b", "k": "c"}, true)
+
+	picked := m.Pick(1, "k", "missing")
+	if want := map[interface{}]interface{}{1: "a", "k": "c"}; !reflect.DeepEqual(picked.Map(), want) {
+		t.Errorf("Pick = %v, want %v", picked.Map(), want)
+	}
+	omitted := m.Omit(1, "missing")
+	if want := map[interface{}]interface{}{2: "b", "k": "c"}; !reflect.DeepEqual(omitted.Map(), want) {
+		t.Errorf("Omit = %v, want %v", omitted.Map(), want)
+	}
+	if m.Size() != 3 {
+		t.Errorf("source Size = %d, want it unchanged", m.Size())
+	}
+	if m.Pick().Size() != 0 {
+		t.Error("Pick with no keys should be empty")
+	}
+	if m.Omit().Size() != 3 {
+		t.Error("Omit with no keys should keep everything")
+	}
+
+	picked.Set(2, "new")
+	if m.Get(2) != "b" {
+		t.Error("modifying the picked map changed the source")
+	}
+}
+
+func TestStrAnyMap_PickOmit(t *testing.T) {
+	m := gmap.NewStrAnyMapFrom(map[string]interface{}{"id": 1, "name": "john", "password": "secret"})
+
+	if want := map[string]interface{}{"id": 1, "name": "john"}; !reflect.DeepEqual(m.Pick("id", "name", "missing").Map(), want) {
+		t.Errorf("Pick = %v, want %v", m.Pick("id", "name", "missing").Map(), want)
+	}
+	if want := map[string]interface{}{"id": 1, "name": "john"}; !reflect.DeepEqual(m.Omit("password", "missing").Map(), want) {
+		t.Errorf("Omit = %v, want %v", m.Omit("password").Map(), want)
+	}
+	if m.Pick("missing").Size() != 0 {
+		t.Error("Pick with only missing keys should be empty")
+	}
+}
+
+func TestIntAnyMap_PickOmit(t *testing.T) {
+	m := gmap.NewIntAnyMapFrom(map[int]interface{}{1: "a", 2: "b", 3: "c"})
+
+	if want := map[int]interface{}{1: "a", 3: "c"}; !reflect.DeepEqual(m.Pick(1, 3, 4).Map(), want) {
+		t.Errorf("Pick = %v, want %v", m.Pick(1, 3, 4).Map(), want)
+	}
+	if want := map[int]interface{}{2: "b"}; !reflect.DeepEqual(m.Omit(1, 3, 4).Map(), want) {
+		t.Errorf("Omit = %v, want %v", m.Omit(1, 3, 4).Map(), want)
+	}
+}
@@ -0,0 +1,60 @@
+package gmap_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestStrAnyMap_Sorted(t *testing.T) {
+	m := gmap.NewStrAnyMapFrom(map[string]interface{}{"b": 2, "a": 1, "d": 4, "c": 3, "B": 0}, true)
+	if got, want := m.SortedKeys(), []string{"B", "a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedKeys = %v, want %v", got, want)
+	}
+
+	var keys []string
+	m.IteratorSorted(func(k string, v interface{}) bool {
+		keys = append(keys, k)
+		return k != "b"
+	})
+	if want := []string{"B", "a", "b"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("IteratorSorted visited %v, want %v", keys, want)
+	}
+}
+
+func TestIntAnyMap_Sorted(t *testing.T) {
+	m := gmap.NewIntAnyMapFrom(map[int]interface{}{10: "j", -1: "z", 2: "b", 100: "x"})
+	if got, want := m.SortedKeys(), []int{-1, 2, 10, 100}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedKeys = %v, want %v", got, want)
+	}
+
+	var values []interface{}
+	m.IteratorSorted(func(k int, v interface{}) bool {
+		values = append(values, v)
+		return len(values) < 2
+	})
+	if want := []interface{}{"z", "b"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("IteratorSorted visited %v, want %v", values, want)
+	}
+}
+
+func TestIntIntMap_Sorted(t *testing.T) {
+	m := gmap.NewIntIntMapFrom(map[int]int{3: 30, 1: 10, 2: 20, 20: 200})
+	if got, want := m.SortedKeys(), []int{1, 2, 3, 20}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedKeys = %v, want %v", got, want)
+	}
+
+	var pairs [][2]int
+	m.IteratorSorted(func(k int, v int) bool {
+		pairs = append(pairs, [2]int{k, v})
+		return true
+	})
+	if want := [][2]int{{1, 10}, {2, 20}, {3, 30}, {20, 200}}; !reflect.DeepEqual(pairs, want) {
+		t.Errorf("IteratorSorted visited %v, want %v", pairs, want)
+	}
+
+	if keys := gmap.NewIntIntMap().SortedKeys(); len(keys) != 0 {
+		t.Errorf("SortedKeys of empty map = %v", keys)
+	}
+}
@@ -0,0 +1,66 @@
+package gmap_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+)
+
+func TestStrAnyMap_Struct(t *testing.T) {
+	type Config struct {
+		Name    string
+		Port    int `json:"listen_port"`
+		Debug   bool
+		Timeout float64
+		Unset   string
+	}
+	m := gmap.NewStrAnyMapFrom(map[string]interface{}{
+		"name":        "admin",
+		"listen_port": "8080",
+		"DEBUG":       "true",
+		"timeout":     "1.5",
+		"extra":       "ignored",
+	})
+	var config Config
+	if err := m.Struct(&config); err != nil {
+		t.Fatal(err)
+	}
+	want := Config{Name: "admin", Port: 8080, Debug: true, Timeout: 1.5}
+	if config != want {
+		t.Errorf("Struct = %+v, want %+v", config, want)
+	}
+}
+
+func TestStrAnyMap_Struct_Nested(t *testing.T) {
+	type Database struct {
+		Host string
+		Port int
+	}
+	type Config struct {
+		App      string
+		Database Database `json:"db"`
+	}
+	m := gmap.NewStrAnyMapFrom(map[string]interface{}{
+		"app": "admin",
+		"db": map[string]interface{}{
+			"host": "127.0.0.1",
+			"port": "3306",
+		},
+	})
+	var config Config
+	if err := m.Struct(&config); err != nil {
+		t.Fatal(err)
+	}
+	want := Config{App: "admin", Database: Database{Host: "127.0.0.1", Port: 3306}}
+	if config != want {
+		t.Errorf("Struct = %+v, want %+v", config, want)
+	}
+}
+
+func TestStrAnyMap_Struct_InvalidPointer(t *testing.T) {
+	m := gmap.NewStrAnyMapFrom(map[string]interface{}{"name": "admin"})
+	var config struct{ Name string }
+	if err := m.Struct(config); err == nil {
+		t.Error("binding to a non-pointer should return an error")
+	}
+}
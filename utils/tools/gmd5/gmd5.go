@@ -3,8 +3,10 @@ package gmd5
 
 import (
 	"crypto/md5"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
 	"io"
@@ -96,3 +98,27 @@ func MustEncryptFile(path string) string {
 	}
 	return result
 }
+
+// Verify 计算任意类型变量 `data` 的 MD5 值，并与十六进制字符串 `expectedHex` 比较是否一致。
+// 它使用 gconv 包将 `data` 转换为其字节类型。
+// 比较使用 crypto/subtle.ConstantTimeCompare 以常量时间完成，可避免校验签名时的时序攻击。
+// 如果 `expectedHex` 不是合法的十六进制字符串，则返回错误。
+func Verify(data interface{}, expectedHex string) (bool, error) {
+	return verifyBytes(gconv.Bytes(data), expectedHex)
+}
+
+// VerifyString 计算字符串 `data` 的 MD5 值，并与十六进制字符串 `expectedHex` 以常量时间比较是否一致。
+// 如果 `expectedHex` 不是合法的十六进制字符串，则返回错误。
+func VerifyString(data, expectedHex string) (bool, error) {
+	return verifyBytes([]byte(data), expectedHex)
+}
+
+// verifyBytes 以常量时间比较 `data` 的 MD5 值与 `expectedHex`。
+func verifyBytes(data []byte, expectedHex string) (bool, error) {
+	expected, err := hex.DecodeString(expectedHex)
+	if err != nil {
+		return false, gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid md5 hex string "%s"`, expectedHex)
+	}
+	sum := md5.Sum(data)
+	return subtle.ConstantTimeCompare(sum[:], expected) == 1, nil
+}
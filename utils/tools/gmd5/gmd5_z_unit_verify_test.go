@@ -0,0 +1,51 @@
+package gmd5_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmd5"
+)
+
+// md5 of "hello".
+const helloMd5 = "5d41402abc4b2a76b9719d911017c592"
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     interface{}
+		expected string
+		want     bool
+	}{
+		{"match", "hello", helloMd5, true},
+		{"match upper-case hex", "hello", strings.ToUpper(helloMd5), true},
+		{"match bytes", []byte("hello"), helloMd5, true},
+		{"wrong hash", "hello", "00000000000000000000000000000000", false},
+		{"wrong data", "world", helloMd5, false},
+		{"short hash", "hello", helloMd5[:8], false},
+	}
+	for _, tt := range tests {
+		ok, err := gmd5.Verify(tt.data, tt.expected)
+		if err != nil || ok != tt.want {
+			t.Errorf("%s: Verify = %v, %v, want %v", tt.name, ok, err, tt.want)
+		}
+	}
+	if ok, err := gmd5.VerifyString("hello", helloMd5); err != nil || !ok {
+		t.Errorf("VerifyString = %v, %v, want true", ok, err)
+	}
+}
+
+func TestVerify_MalformedHex(t *testing.T) {
+	for _, expected := range []string{"not-hex", "abc", helloMd5 + "zz"} {
+		ok, err := gmd5.Verify("hello", expected)
+		if ok || err == nil {
+			t.Errorf("Verify(%q) = %v, %v, want an error", expected, ok, err)
+			continue
+		}
+		if gerror.Code(err) != gcode.CodeInvalidParameter {
+			t.Errorf("Verify(%q) code = %v, want CodeInvalidParameter", expected, gerror.Code(err))
+		}
+	}
+}
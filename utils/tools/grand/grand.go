@@ -194,3 +194,43 @@ func Meet(num, total int) bool {
 func MeetProb(prob float32) bool {
 	return Intn(1e7) < int(prob*1e7)
 }
+
+// WeightedIndex 按权重随机返回 `weights` 中的一个索引，每个索引被选中的概率与其权重成正比。
+// 负数权重按 0 处理，权重为 0 的索引不会被选中。
+// 如果 `weights` 为空或所有权重均不大于 0，则返回 -1。
+//
+// 注意：权重总和应小于 math.MaxUint32，否则结果分布会出现偏差。
+func WeightedIndex(weights []int) int {
+	total := 0
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total <= 0 {
+		return -1
+	}
+	n := Intn(total)
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if n < w {
+			return i
+		}
+		n -= w
+	}
+	return -1
+}
+
+// WeightedChoice 按权重 `weights` 从 `items` 中随机返回一个元素，规则同 WeightedIndex。
+// 如果 `items` 与 `weights` 长度不一致或所有权重均不大于 0，则返回 nil。
+func WeightedChoice(items []interface{}, weights []int) interface{} {
+	if len(items) != len(weights) {
+		return nil
+	}
+	if i := WeightedIndex(weights); i >= 0 {
+		return items[i]
+	}
+	return nil
+}
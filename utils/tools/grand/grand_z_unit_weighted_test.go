@@ -0,0 +1,76 @@
+package grand_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/grand"
+)
+
+func TestWeightedIndex_Distribution(t *testing.T) {
+	var (
+		weights = []int{1, 0, 3, -5, 6}
+		total   = 10.0
+		draws   = 100000
+		counts  = make([]int, len(weights))
+	)
+	for i := 0; i < draws; i++ {
+		index := grand.WeightedIndex(weights)
+		if index < 0 || index >= len(weights) {
+			t.Fatalf("WeightedIndex = %d, out of range", index)
+		}
+		counts[index]++
+	}
+	for i, w := range weights {
+		var (
+			want = math.Max(float64(w), 0) / total
+			got  = float64(counts[i]) / float64(draws)
+		)
+		// The standard deviation is at most 0.0016 for 100000 draws, so 0.01 is far beyond noise.
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("index %d chosen with frequency %.4f, want about %.4f", i, got, want)
+		}
+	}
+	if counts[1] != 0 || counts[3] != 0 {
+		t.Errorf("indexes with non-positive weights were chosen: %v", counts)
+	}
+}
+
+func TestWeightedIndex_Edge(t *testing.T) {
+	tests := []struct {
+		weights []int
+		want    int
+	}{
+		{[]int{5}, 0},
+		{[]int{0, 0, 7}, 2},
+		{[]int{-1, 3, 0}, 1},
+		{[]int{0, 0}, -1},
+		{[]int{-1, -2}, -1},
+		{nil, -1},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if got := grand.WeightedIndex(tt.weights); got != tt.want {
+				t.Fatalf("WeightedIndex(%v) = %d, want %d", tt.weights, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	items := []interface{}{"a", "b", "c"}
+	for i := 0; i < 100; i++ {
+		if got := grand.WeightedChoice(items, []int{0, 1, 0}); got != "b" {
+			t.Fatalf("WeightedChoice = %v, want b", got)
+		}
+	}
+	if got := grand.WeightedChoice(items, []int{1, 1}); got != nil {
+		t.Errorf("WeightedChoice with mismatched lengths = %v, want nil", got)
+	}
+	if got := grand.WeightedChoice(items, []int{0, 0, 0}); got != nil {
+		t.Errorf("WeightedChoice with zero weights = %v, want nil", got)
+	}
+	if got := grand.WeightedChoice(nil, nil); got != nil {
+		t.Errorf("WeightedChoice(nil) = %v, want nil", got)
+	}
+}
@@ -7,8 +7,13 @@ import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
+	"reflect"
+	"sort"
 )
 
+// converter 用于集合类型转换时带错误检查的元素转换。
+var converter = gconv.NewConverter()
+
 // Set 是一个由 interface{} 项组成的集合。
 type Set struct {
 	mu   rwmutex.RWMutex
@@ -56,6 +61,32 @@ func (set *Set) Iterator(f func(v interface{}) bool) {
 	}
 }
 
+// ForEach 遍历集合中的所有项，只读模式，并向回调函数 `f` 传入本次遍历中从 0 开始递增的索引。
+// 索引仅在单次调用中有效，不同调用之间项的顺序不保证一致。
+// 如果回调函数 `f` 返回 true，则继续迭代；否则停止迭代。
+func (set *Set) ForEach(f func(index int, item interface{}) bool) {
+	for i, item := range set.Slice() {
+		if !f(i, item) {
+			break
+		}
+	}
+}
+
+// IteratorSorted 按比较函数 `less` 定义的顺序遍历集合中的所有项，只读模式，
+// 适用于需要确定性输出的场景。它会对集合项做一次切片拷贝后排序。
+// 如果回调函数 `f` 返回 true，则继续迭代；否则停止迭代。
+func (set *Set) IteratorSorted(less func(a, b interface{}) bool, f func(item interface{}) bool) {
+	items := set.Slice()
+	sort.Slice(items, func(i, j int) bool {
+		return less(items[i], items[j])
+	})
+	for _, item := range items {
+		if !f(item) {
+			break
+		}
+	}
+}
+
 // Add 添加一个或多个项到集合中。
 func (set *Set) Add(items ...interface{}) {
 	set.mu.Lock()
@@ -68,6 +99,21 @@ func (set *Set) Add(items ...interface{}) {
 	set.mu.Unlock()
 }
 
+// AddSlice 将切片 `slice` 中的所有元素在一次写锁内添加到集合中，元素通过 gconv.Interfaces 转换，
+// 适用于从配置数组加载数据。`slice` 也可以是另一个 *Set。nil 或空切片不做任何操作。
+func (set *Set) AddSlice(slice interface{}) {
+	var items []interface{}
+	if other, ok := slice.(*Set); ok {
+		items = other.Slice()
+	} else {
+		items = gconv.Interfaces(slice)
+	}
+	if len(items) == 0 {
+		return
+	}
+	set.Add(items...)
+}
+
 // AddIfNotExist 检查项是否存在于集合中，
 // 如果项不存在于集合中，则将项添加到集合中并返回 true，
 // 否则不执行任何操作并返回 false。
@@ -301,6 +347,28 @@ func (set *Set) IsSubsetOf(other *Set) bool {
 	return true
 }
 
+// Overlaps 检查当前集合与 `other` 是否存在共同的元素，遍历较小的集合在较大的集合中查找。
+// 集合与自身比较时，非空即返回 true。
+func (set *Set) Overlaps(other *Set) bool {
+	unlock := rLockBoth(&set.mu, &other.mu)
+	defer unlock()
+	small, large := set.data, other.data
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for key := range small {
+		if _, ok := large[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisjoint 检查当前集合与 `other` 是否没有共同的元素，与 Overlaps 相反。
+func (set *Set) IsDisjoint(other *Set) bool {
+	return !set.Overlaps(other)
+}
+
 // Union 返回一个新集合，
 // 该集合是 `set` 和 `others` 的并集。
 // 这意味着，`newSet` 中的所有项都在 `set` 或 `others` 中。
@@ -473,11 +541,108 @@ func (set *Set) Walk(f func(item interface{}) interface{}) *Set {
 	return set
 }
 
+// Partition 使用函数 `f` 将集合一次性划分为两个新集合：`f` 返回 true 的项放入 `matched`，其余放入 `rest`。
+// 当前集合不会被修改，新集合的并发安全设置与当前集合保持一致。
+func (set *Set) Partition(f func(item interface{}) bool) (matched, rest *Set) {
+	matched = NewSet(set.mu.IsSafe())
+	rest = NewSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		if f(k) {
+			matched.data[k] = struct{}{}
+		} else {
+			rest.data[k] = struct{}{}
+		}
+	}
+	return
+}
+
+// ToStrSet 将集合转换为字符串集合，元素使用 gconv 转换为字符串，
+// nil 元素以及无法转换的元素会被跳过。
+// 新集合的并发安全设置与当前集合保持一致。
+func (set *Set) ToStrSet() *StrSet {
+	newSet := NewStrSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		if k == nil {
+			continue
+		}
+		if v, err := converter.String(k); err == nil {
+			newSet.data[v] = struct{}{}
+		}
+	}
+	return newSet
+}
+
+// ToIntSet 将集合转换为整数集合，元素使用 gconv 转换为整数，
+// nil 元素以及无法转换的元素（例如非数字字符串）会被跳过。
+// 新集合的并发安全设置与当前集合保持一致。
+func (set *Set) ToIntSet() *IntSet {
+	newSet := NewIntSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		if k == nil {
+			continue
+		}
+		if v, err := converter.Int(k); err == nil {
+			newSet.data[v] = struct{}{}
+		}
+	}
+	return newSet
+}
+
 // MarshalJSON implements the interface MarshalJSON for json.Marshal.
+// 注意：为了性能，输出的元素顺序是不确定的，需要确定性输出时请使用 MarshalJSONSorted。
 func (set Set) MarshalJSON() ([]byte, error) {
 	return json.Marshal(set.Slice())
 }
 
+// MarshalJSONSorted 将集合排序后序列化为 JSON，相同内容的集合每次都会得到相同的输出。
+// 排序规则：数值类型的项在前并按数值升序，其余项在后并按字符串字典序升序。
+func (set *Set) MarshalJSONSorted() ([]byte, error) {
+	items := set.Slice()
+	sort.Slice(items, func(i, j int) bool {
+		return sortedItemLess(items[i], items[j])
+	})
+	return json.Marshal(items)
+}
+
+// sortedItemLess 是 MarshalJSONSorted 使用的比较函数：数值在前按数值比较，其余按字符串比较。
+func sortedItemLess(a, b interface{}) bool {
+	aNum, aIsNum := sortedItemNumber(a)
+	bNum, bIsNum := sortedItemNumber(b)
+	switch {
+	case aIsNum && bIsNum:
+		if aNum != bNum {
+			return aNum < bNum
+		}
+	case aIsNum:
+		return true
+	case bIsNum:
+		return false
+	}
+	return gconv.String(a) < gconv.String(b)
+}
+
+// sortedItemNumber 判断 `v` 是否为数值类型，是则返回其 float64 值。
+func sortedItemNumber(v interface{}) (float64, bool) {
+	switch value := v.(type) {
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return gconv.Float64(v), true
+	case interface{ Float64() (float64, error) }:
+		// 兼容 UnmarshalUseNumber 解析得到的 json.Number。
+		if f, err := value.Float64(); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
 // UnmarshalJSON implements the interface UnmarshalJSON for json.Unmarshal.
 func (set *Set) UnmarshalJSON(b []byte) error {
 	set.mu.Lock()
@@ -528,3 +693,21 @@ func (set *Set) DeepCopy() interface{} {
 	}
 	return NewFrom(data, set.mu.IsSafe())
 }
+
+// rLockBoth 按地址顺序对两个读写锁加读锁并返回解锁函数，
+// 保证并发地以不同顺序比较两个集合时加锁顺序一致，避免与等待中的写锁形成死锁。
+func rLockBoth(a, b *rwmutex.RWMutex) (unlock func()) {
+	if a == b {
+		a.RLock()
+		return a.RUnlock
+	}
+	if reflect.ValueOf(a).Pointer() > reflect.ValueOf(b).Pointer() {
+		a, b = b, a
+	}
+	a.RLock()
+	b.RLock()
+	return func() {
+		b.RUnlock()
+		a.RUnlock()
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
+	"sort"
 )
 
 // IntSet 由 int 项组成的集合。
@@ -60,6 +61,14 @@ func (set *IntSet) Add(item ...int) {
 	set.mu.Unlock()
 }
 
+// AddSlice 将切片 `slice` 中的所有元素在一次写锁内添加到集合中。nil 或空切片不做任何操作。
+func (set *IntSet) AddSlice(slice []int) {
+	if len(slice) == 0 {
+		return
+	}
+	set.Add(slice...)
+}
+
 // AddIfNotExist 检查集合中是否存在 `item`，
 // 如果不存在，则将 `item` 添加到集合中并返回 true；
 // 否则，不执行任何操作并返回 false。
@@ -259,6 +268,28 @@ func (set *IntSet) IsSubsetOf(other *IntSet) bool {
 	return true
 }
 
+// Overlaps 检查当前集合与 `other` 是否存在共同的元素，遍历较小的集合在较大的集合中查找。
+// 集合与自身比较时，非空即返回 true。
+func (set *IntSet) Overlaps(other *IntSet) bool {
+	unlock := rLockBoth(&set.mu, &other.mu)
+	defer unlock()
+	small, large := set.data, other.data
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for key := range small {
+		if _, ok := large[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisjoint 检查当前集合与 `other` 是否没有共同的元素，与 Overlaps 相反。
+func (set *IntSet) IsDisjoint(other *IntSet) bool {
+	return !set.Overlaps(other)
+}
+
 // Union 返回一个新集合，该集合是 `set` 和 `other` 的并集。
 // 这意味着，`newSet` 中的所有项都在 `set` 或 `other` 中。
 func (set *IntSet) Union(others ...*IntSet) (newSet *IntSet) {
@@ -425,11 +456,88 @@ func (set *IntSet) Walk(f func(item int) int) *IntSet {
 	return set
 }
 
+// Partition 使用函数 `f` 将集合一次性划分为两个新集合：`f` 返回 true 的项放入 `matched`，其余放入 `rest`。
+// 当前集合不会被修改，新集合的并发安全设置与当前集合保持一致。
+func (set *IntSet) Partition(f func(item int) bool) (matched, rest *IntSet) {
+	matched = NewIntSet(set.mu.IsSafe())
+	rest = NewIntSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		if f(k) {
+			matched.data[k] = struct{}{}
+		} else {
+			rest.data[k] = struct{}{}
+		}
+	}
+	return
+}
+
+// Combinations 返回集合中所有包含 `k` 个元素的组合（子集）。
+// 元素先按升序排序，因此结果及每个组合内的元素顺序都是确定的。
+// 如果 `k` 大于集合大小，则返回空结果；如果 `k` <= 0，则返回只包含一个空组合的结果。
+func (set *IntSet) Combinations(k int) [][]int {
+	if k <= 0 {
+		return [][]int{{}}
+	}
+	items := set.Slice()
+	if k > len(items) {
+		return [][]int{}
+	}
+	sort.Ints(items)
+	var (
+		result  = make([][]int, 0)
+		indexes = make([]int, k)
+	)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	for {
+		combination := make([]int, k)
+		for i, index := range indexes {
+			combination[i] = items[index]
+		}
+		result = append(result, combination)
+		// 从右向左找到第一个还能后移的位置，后移后重置其右侧的位置。
+		i := k - 1
+		for i >= 0 && indexes[i] == len(items)-k+i {
+			i--
+		}
+		if i < 0 {
+			return result
+		}
+		indexes[i]++
+		for j := i + 1; j < k; j++ {
+			indexes[j] = indexes[j-1] + 1
+		}
+	}
+}
+
+// ToAnySet 将集合转换为 interface{} 项组成的集合。
+// 新集合的并发安全设置与当前集合保持一致。
+func (set *IntSet) ToAnySet() *Set {
+	newSet := NewSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		newSet.data[k] = struct{}{}
+	}
+	return newSet
+}
+
 // MarshalJSON implements the interface MarshalJSON for json.Marshal.
+// 注意：为了性能，输出的元素顺序是不确定的，需要确定性输出时请使用 MarshalJSONSorted。
 func (set IntSet) MarshalJSON() ([]byte, error) {
 	return json.Marshal(set.Slice())
 }
 
+// MarshalJSONSorted 将集合按升序排序后序列化为 JSON，相同内容的集合每次都会得到相同的输出。
+func (set *IntSet) MarshalJSONSorted() ([]byte, error) {
+	items := set.Slice()
+	sort.Ints(items)
+	return json.Marshal(items)
+}
+
 // UnmarshalJSON implements the interface UnmarshalJSON for json.Unmarshal.
 func (set *IntSet) UnmarshalJSON(b []byte) error {
 	set.mu.Lock()
@@ -8,6 +8,7 @@ import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/json"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/rwmutex"
+	"sort"
 	"strings"
 )
 
@@ -61,6 +62,14 @@ func (set *StrSet) Add(item ...string) {
 	set.mu.Unlock()
 }
 
+// AddSlice 将切片 `slice` 中的所有元素在一次写锁内添加到集合中。nil 或空切片不做任何操作。
+func (set *StrSet) AddSlice(slice []string) {
+	if len(slice) == 0 {
+		return
+	}
+	set.Add(slice...)
+}
+
 // AddIfNotExist 检查集合中是否存在 `item`，如果不存在，则将其添加到集合中并返回 true；
 // 如果存在，则不执行任何操作并返回 false。
 func (set *StrSet) AddIfNotExist(item string) bool {
@@ -281,6 +290,28 @@ func (set *StrSet) IsSubsetOf(other *StrSet) bool {
 	return true
 }
 
+// Overlaps 检查当前集合与 `other` 是否存在共同的元素，遍历较小的集合在较大的集合中查找。
+// 集合与自身比较时，非空即返回 true。
+func (set *StrSet) Overlaps(other *StrSet) bool {
+	unlock := rLockBoth(&set.mu, &other.mu)
+	defer unlock()
+	small, large := set.data, other.data
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for key := range small {
+		if _, ok := large[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisjoint 检查当前集合与 `other` 是否没有共同的元素，与 Overlaps 相反。
+func (set *StrSet) IsDisjoint(other *StrSet) bool {
+	return !set.Overlaps(other)
+}
+
 // Union 返回一个新集合，该集合是 `set` 和 `other` 的并集。
 // 这意味着，`newSet` 中的所有项都在 `set` 或 `other` 中。
 func (set *StrSet) Union(others ...*StrSet) (newSet *StrSet) {
@@ -446,11 +477,72 @@ func (set *StrSet) Walk(f func(item string) string) *StrSet {
 	return set
 }
 
+// Partition 使用函数 `f` 将集合一次性划分为两个新集合：`f` 返回 true 的项放入 `matched`，其余放入 `rest`。
+// 当前集合不会被修改，新集合的并发安全设置与当前集合保持一致。
+func (set *StrSet) Partition(f func(item string) bool) (matched, rest *StrSet) {
+	matched = NewStrSet(set.mu.IsSafe())
+	rest = NewStrSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		if f(k) {
+			matched.data[k] = struct{}{}
+		} else {
+			rest.data[k] = struct{}{}
+		}
+	}
+	return
+}
+
+// CartesianProduct 返回多个集合 `sets` 的笛卡尔积，每个结果依次包含各集合中的一个元素。
+// 各集合的元素先按升序排序，因此结果的顺序是确定的。
+// 任意一个集合为 nil 或为空时返回空结果；未传入任何集合时返回只包含一个空元组的结果。
+func CartesianProduct(sets ...*StrSet) [][]string {
+	result := [][]string{{}}
+	for _, set := range sets {
+		if set == nil {
+			return [][]string{}
+		}
+		items := set.Slice()
+		sort.Strings(items)
+		product := make([][]string, 0, len(result)*len(items))
+		for _, prefix := range result {
+			for _, item := range items {
+				tuple := make([]string, len(prefix), len(prefix)+1)
+				copy(tuple, prefix)
+				product = append(product, append(tuple, item))
+			}
+		}
+		result = product
+	}
+	return result
+}
+
+// ToAnySet 将集合转换为 interface{} 项组成的集合。
+// 新集合的并发安全设置与当前集合保持一致。
+func (set *StrSet) ToAnySet() *Set {
+	newSet := NewSet(set.mu.IsSafe())
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.data {
+		newSet.data[k] = struct{}{}
+	}
+	return newSet
+}
+
 // MarshalJSON implements the interface MarshalJSON for json.Marshal.
+// 注意：为了性能，输出的元素顺序是不确定的，需要确定性输出时请使用 MarshalJSONSorted。
 func (set StrSet) MarshalJSON() ([]byte, error) {
 	return json.Marshal(set.Slice())
 }
 
+// MarshalJSONSorted 将集合按升序排序后序列化为 JSON，相同内容的集合每次都会得到相同的输出。
+func (set *StrSet) MarshalJSONSorted() ([]byte, error) {
+	items := set.Slice()
+	sort.Strings(items)
+	return json.Marshal(items)
+}
+
 // UnmarshalJSON implements the interface UnmarshalJSON for json.Unmarshal.
 func (set *StrSet) UnmarshalJSON(b []byte) error {
 	set.mu.Lock()
@@ -0,0 +1,50 @@
+package gset_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestSet_AddSlice(t *testing.T) {
+	set := gset.NewFrom([]interface{}{1, "a"})
+	set.AddSlice([]interface{}{1, 2, "a", "b"})
+	set.AddSlice([]string{"b", "c"})
+	set.AddSlice([]int{2, 3})
+	set.AddSlice(gset.NewFrom([]interface{}{3, "d"}))
+	set.AddSlice(nil)
+	set.AddSlice([]string{})
+
+	want := []interface{}{1, 2, 3, "a", "b", "c", "d"}
+	if set.Size() != len(want) {
+		t.Errorf("Size = %d, want %d: %v", set.Size(), len(want), set.Slice())
+	}
+	for _, item := range want {
+		if !set.Contains(item) {
+			t.Errorf("set should contain %v", item)
+		}
+	}
+}
+
+func TestIntSet_AddSlice(t *testing.T) {
+	set := gset.NewIntSetFrom([]int{1, 2})
+	set.AddSlice([]int{2, 3, 3, 4})
+	set.AddSlice(nil)
+	items := set.Slice()
+	sort.Ints(items)
+	if len(items) != 4 || items[0] != 1 || items[3] != 4 {
+		t.Errorf("AddSlice = %v, want [1 2 3 4]", items)
+	}
+}
+
+func TestStrSet_AddSlice(t *testing.T) {
+	set := gset.NewStrSetFrom([]string{"a"})
+	set.AddSlice([]string{"a", "b", "b", "c"})
+	set.AddSlice(nil)
+	items := set.Slice()
+	sort.Strings(items)
+	if len(items) != 3 || items[0] != "a" || items[2] != "c" {
+		t.Errorf("AddSlice = %v, want [a b c]", items)
+	}
+}
@@ -0,0 +1,62 @@
+package gset_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestIntSet_Combinations(t *testing.T) {
+	set := gset.NewIntSetFrom([]int{4, 2, 3, 1})
+	tests := []struct {
+		k    int
+		want [][]int
+	}{
+		{2, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{3, [][]int{{1, 2, 3}, {1, 2, 4}, {1, 3, 4}, {2, 3, 4}}},
+		{4, [][]int{{1, 2, 3, 4}}},
+		{1, [][]int{{1}, {2}, {3}, {4}}},
+		{5, [][]int{}},
+		{0, [][]int{{}}},
+		{-1, [][]int{{}}},
+	}
+	for _, tt := range tests {
+		if got := set.Combinations(tt.k); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Combinations(%d) = %v, want %v", tt.k, got, tt.want)
+		}
+	}
+	if got := gset.NewIntSet().Combinations(1); len(got) != 0 {
+		t.Errorf("Combinations of empty set = %v, want empty", got)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	var (
+		colors = gset.NewStrSetFrom([]string{"red", "blue"})
+		sizes  = gset.NewStrSetFrom([]string{"S", "M", "L"})
+	)
+	want := [][]string{
+		{"blue", "L"}, {"blue", "M"}, {"blue", "S"},
+		{"red", "L"}, {"red", "M"}, {"red", "S"},
+	}
+	if got := gset.CartesianProduct(colors, sizes); !reflect.DeepEqual(got, want) {
+		t.Errorf("CartesianProduct = %v, want %v", got, want)
+	}
+	if got := gset.CartesianProduct(colors, sizes, gset.NewStrSetFrom([]string{"x", "y"})); len(got) != 12 {
+		t.Errorf("CartesianProduct of three sets has %d tuples, want 12", len(got))
+	}
+
+	if got := gset.CartesianProduct(colors); !reflect.DeepEqual(got, [][]string{{"blue"}, {"red"}}) {
+		t.Errorf("CartesianProduct of one set = %v", got)
+	}
+	if got := gset.CartesianProduct(colors, gset.NewStrSet()); len(got) != 0 {
+		t.Errorf("CartesianProduct with an empty set = %v, want empty", got)
+	}
+	if got := gset.CartesianProduct(colors, nil); len(got) != 0 {
+		t.Errorf("CartesianProduct with a nil set = %v, want empty", got)
+	}
+	if got := gset.CartesianProduct(); !reflect.DeepEqual(got, [][]string{{}}) {
+		t.Errorf("CartesianProduct() = %v, want [[]]", got)
+	}
+}
@@ -0,0 +1,56 @@
+package gset_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestSet_ToStrSet(t *testing.T) {
+	set := gset.NewFrom([]interface{}{1, "a", 2.5, true, nil, "1"})
+	strSet := set.ToStrSet()
+	// 1 and "1" collapse into the same string member, nil is skipped.
+	if strSet.Size() != 4 {
+		t.Errorf("Size = %d, want 4: %v", strSet.Size(), strSet.Slice())
+	}
+	for _, s := range []string{"1", "a", "2.5", "true"} {
+		if !strSet.Contains(s) {
+			t.Errorf("StrSet should contain %q", s)
+		}
+	}
+	if set.Size() != 6 {
+		t.Errorf("source Size = %d, want it unchanged", set.Size())
+	}
+}
+
+func TestSet_ToIntSet(t *testing.T) {
+	intSet := gset.NewFrom([]interface{}{1, "2", int64(3), "abc", nil}).ToIntSet()
+	if intSet.Size() != 3 {
+		t.Errorf("Size = %d, want 3: %v", intSet.Size(), intSet.Slice())
+	}
+	for _, i := range []int{1, 2, 3} {
+		if !intSet.Contains(i) {
+			t.Errorf("IntSet should contain %d", i)
+		}
+	}
+}
+
+func TestIntSet_ToAnySet(t *testing.T) {
+	set := gset.NewIntSetFrom([]int{1, 2, 3}, true).ToAnySet()
+	if set.Size() != 3 {
+		t.Errorf("Size = %d, want 3", set.Size())
+	}
+	for _, i := range []int{1, 2, 3} {
+		if !set.Contains(i) {
+			t.Errorf("Set should contain %d", i)
+		}
+	}
+	if set.Contains("1") {
+		t.Error("members should keep their int type")
+	}
+
+	strSet := gset.NewStrSetFrom([]string{"a", "b"}).ToAnySet()
+	if strSet.Size() != 2 || !strSet.Contains("a") || !strSet.Contains("b") {
+		t.Errorf("StrSet.ToAnySet = %v", strSet.Slice())
+	}
+}
@@ -0,0 +1,63 @@
+package gset_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestSet_ForEach(t *testing.T) {
+	set := gset.NewFrom([]interface{}{"a", "b", "c", "d"})
+
+	var (
+		indexes []int
+		items   = make(map[interface{}]struct{})
+	)
+	set.ForEach(func(index int, item interface{}) bool {
+		indexes = append(indexes, index)
+		items[item] = struct{}{}
+		return true
+	})
+	if want := []int{0, 1, 2, 3}; !reflect.DeepEqual(indexes, want) {
+		t.Errorf("indexes = %v, want %v", indexes, want)
+	}
+	if len(items) != 4 {
+		t.Errorf("visited %d distinct items, want 4", len(items))
+	}
+
+	count := 0
+	set.ForEach(func(index int, item interface{}) bool {
+		count++
+		return index < 1
+	})
+	if count != 2 {
+		t.Errorf("visited %d items after stopping, want 2", count)
+	}
+}
+
+func TestSet_IteratorSorted(t *testing.T) {
+	set := gset.NewFrom([]interface{}{3, 1, 4, 5, 2})
+
+	var asc []interface{}
+	set.IteratorSorted(func(a, b interface{}) bool {
+		return a.(int) < b.(int)
+	}, func(item interface{}) bool {
+		asc = append(asc, item)
+		return true
+	})
+	if want := []interface{}{1, 2, 3, 4, 5}; !reflect.DeepEqual(asc, want) {
+		t.Errorf("ascending = %v, want %v", asc, want)
+	}
+
+	var desc []interface{}
+	set.IteratorSorted(func(a, b interface{}) bool {
+		return a.(int) > b.(int)
+	}, func(item interface{}) bool {
+		desc = append(desc, item)
+		return len(desc) < 3
+	})
+	if want := []interface{}{5, 4, 3}; !reflect.DeepEqual(desc, want) {
+		t.Errorf("descending with early stop = %v, want %v", desc, want)
+	}
+}
@@ -0,0 +1,57 @@
+package gset_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestSet_MarshalJSONSorted(t *testing.T) {
+	set := gset.NewFrom([]interface{}{"b", 10, "a", 2.5, -1, "10", 3})
+	want := `[-1,2.5,3,10,"10","a","b"]`
+	for i := 0; i < 20; i++ {
+		b, err := set.MarshalJSONSorted()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Fatalf("MarshalJSONSorted = %s, want %s", b, want)
+		}
+	}
+
+	// Sets with the same contents built in a different order marshal identically.
+	other := gset.NewFrom([]interface{}{3, "10", -1, 2.5, "a", 10, "b"})
+	if b, _ := other.MarshalJSONSorted(); string(b) != want {
+		t.Errorf("MarshalJSONSorted = %s, want %s", b, want)
+	}
+
+	if b, _ := gset.New().MarshalJSONSorted(); string(b) != "[]" {
+		t.Errorf("MarshalJSONSorted of empty set = %s, want []", b)
+	}
+}
+
+func TestIntSet_MarshalJSONSorted(t *testing.T) {
+	set := gset.NewIntSetFrom([]int{5, -2, 9, 0, 3})
+	for i := 0; i < 20; i++ {
+		b, err := set.MarshalJSONSorted()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := `[-2,0,3,5,9]`; string(b) != want {
+			t.Fatalf("MarshalJSONSorted = %s, want %s", b, want)
+		}
+	}
+}
+
+func TestStrSet_MarshalJSONSorted(t *testing.T) {
+	set := gset.NewStrSetFrom([]string{"pear", "Apple", "fig", "10", "9"})
+	for i := 0; i < 20; i++ {
+		b, err := set.MarshalJSONSorted()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := `["10","9","Apple","fig","pear"]`; string(b) != want {
+			t.Fatalf("MarshalJSONSorted = %s, want %s", b, want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package gset_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestIntSet_Overlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		overlaps bool
+	}{
+		{"disjoint", []int{1, 2, 3}, []int{4, 5}, false},
+		{"partial", []int{1, 2, 3}, []int{3, 4, 5, 6}, true},
+		{"identical", []int{1, 2}, []int{1, 2}, true},
+		{"subset", []int{2}, []int{1, 2, 3}, true},
+		{"one empty", []int{1, 2}, nil, false},
+		{"both empty", nil, nil, false},
+	}
+	for _, tt := range tests {
+		a, b := gset.NewIntSetFrom(tt.a, true), gset.NewIntSetFrom(tt.b, true)
+		if got := a.Overlaps(b); got != tt.overlaps {
+			t.Errorf("%s: a.Overlaps(b) = %v, want %v", tt.name, got, tt.overlaps)
+		}
+		if got := b.Overlaps(a); got != tt.overlaps {
+			t.Errorf("%s: b.Overlaps(a) = %v, want %v", tt.name, got, tt.overlaps)
+		}
+		if got := a.IsDisjoint(b); got == tt.overlaps {
+			t.Errorf("%s: a.IsDisjoint(b) = %v, want %v", tt.name, got, !tt.overlaps)
+		}
+	}
+}
+
+func TestIntSet_Overlaps_Self(t *testing.T) {
+	set := gset.NewIntSetFrom([]int{1}, true)
+	if !set.Overlaps(set) || set.IsDisjoint(set) {
+		t.Error("non-empty set should overlap itself")
+	}
+	empty := gset.NewIntSet(true)
+	if empty.Overlaps(empty) || !empty.IsDisjoint(empty) {
+		t.Error("empty set should be disjoint with itself")
+	}
+}
+
+func TestStrSet_Overlaps(t *testing.T) {
+	a := gset.NewStrSetFrom([]string{"admin", "guest"}, true)
+	if !a.Overlaps(gset.NewStrSetFrom([]string{"guest", "author"})) {
+		t.Error("sets sharing \"guest\" should overlap")
+	}
+	if !a.IsDisjoint(gset.NewStrSetFrom([]string{"author"})) {
+		t.Error("sets without common elements should be disjoint")
+	}
+	if !a.Overlaps(a) {
+		t.Error("non-empty set should overlap itself")
+	}
+	if !a.IsDisjoint(gset.NewStrSet()) {
+		t.Error("set should be disjoint with an empty set")
+	}
+}
+
+func TestSet_Overlaps(t *testing.T) {
+	a := gset.NewFrom([]interface{}{1, "a"}, true)
+	if !a.Overlaps(gset.NewFrom([]interface{}{"a", 2})) {
+		t.Error("sets sharing \"a\" should overlap")
+	}
+	if !a.IsDisjoint(gset.NewFrom([]interface{}{"1", 2})) {
+		t.Error("sets without common elements should be disjoint")
+	}
+	if !a.Overlaps(a) {
+		t.Error("non-empty set should overlap itself")
+	}
+	if !gset.New().IsDisjoint(gset.New()) {
+		t.Error("empty sets should be disjoint")
+	}
+}
+
+func TestIntSet_Overlaps_Concurrent(t *testing.T) {
+	var (
+		a  = gset.NewIntSetFrom([]int{1, 2, 3}, true)
+		b  = gset.NewIntSetFrom([]int{3, 4, 5}, true)
+		wg sync.WaitGroup
+	)
+	// Comparing in both orders while writing must not deadlock.
+	for i := 0; i < 20; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				a.Overlaps(b)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b.IsDisjoint(a)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				a.Add(100 + i)
+				b.Add(200 + i)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if !a.Overlaps(b) {
+		t.Error("sets sharing 3 should still overlap")
+	}
+}
@@ -0,0 +1,57 @@
+package gset_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+)
+
+func TestIntSet_Partition(t *testing.T) {
+	set := gset.NewIntSetFrom([]int{1, 2, 3, 4, 5, 6, 7})
+	even, odd := set.Partition(func(item int) bool {
+		return item%2 == 0
+	})
+	if !even.Equal(gset.NewIntSetFrom([]int{2, 4, 6})) {
+		t.Errorf("even = %v", even.Slice())
+	}
+	if !odd.Equal(gset.NewIntSetFrom([]int{1, 3, 5, 7})) {
+		t.Errorf("odd = %v", odd.Slice())
+	}
+	if even.Intersect(odd).Size() != 0 {
+		t.Error("partitions should be disjoint")
+	}
+	if !even.Union(odd).Equal(set) {
+		t.Error("union of the partitions should equal the original set")
+	}
+	if set.Size() != 7 {
+		t.Error("source set should be unchanged")
+	}
+
+	all, none := set.Partition(func(item int) bool { return true })
+	if all.Size() != 7 || none.Size() != 0 {
+		t.Errorf("always-true partition sizes = %d, %d", all.Size(), none.Size())
+	}
+}
+
+func TestSet_Partition(t *testing.T) {
+	set := gset.NewFrom([]interface{}{1, 2, 3, 4})
+	even, odd := set.Partition(func(item interface{}) bool {
+		return item.(int)%2 == 0
+	})
+	if even.Size() != 2 || !even.Contains(2) || !even.Contains(4) {
+		t.Errorf("even = %v", even.Slice())
+	}
+	if odd.Size() != 2 || !odd.Contains(1) || !odd.Contains(3) {
+		t.Errorf("odd = %v", odd.Slice())
+	}
+}
+
+func TestStrSet_Partition(t *testing.T) {
+	set := gset.NewStrSetFrom([]string{"admin", "guest", "author"})
+	a, rest := set.Partition(func(item string) bool {
+		return item[0] == 'a'
+	})
+	if !a.Equal(gset.NewStrSetFrom([]string{"admin", "author"})) || !rest.Equal(gset.NewStrSetFrom([]string{"guest"})) {
+		t.Errorf("partition = %v, %v", a.Slice(), rest.Slice())
+	}
+}
@@ -0,0 +1,95 @@
+package gstr
+
+import (
+	"strings"
+	"unicode"
+)
+
+// accentFoldTable 是带重音符号的拉丁字母到其基础字母的映射表，键为基础字母，值为对应的重音字母。
+var accentFoldTable = map[string]string{
+	"A":  "ÀÁÂÃÄÅĀĂĄǍȀȂȦ",
+	"a":  "àáâãäåāăąǎȁȃȧ",
+	"C":  "ÇĆĈĊČ",
+	"c":  "çćĉċč",
+	"D":  "ĎĐ",
+	"d":  "ďđ",
+	"E":  "ÈÉÊËĒĔĖĘĚȄȆȨ",
+	"e":  "èéêëēĕėęěȅȇȩ",
+	"G":  "ĜĞĠĢǦ",
+	"g":  "ĝğġģǧ",
+	"H":  "ĤĦ",
+	"h":  "ĥħ",
+	"I":  "ÌÍÎÏĨĪĬĮİǏȈȊ",
+	"i":  "ìíîïĩīĭįıǐȉȋ",
+	"J":  "Ĵ",
+	"j":  "ĵ",
+	"K":  "ĶǨ",
+	"k":  "ķǩ",
+	"L":  "ĹĻĽĿŁ",
+	"l":  "ĺļľŀł",
+	"N":  "ÑŃŅŇǸ",
+	"n":  "ñńņňǹ",
+	"O":  "ÒÓÔÕÖØŌŎŐǑȌȎȮ",
+	"o":  "òóôõöøōŏőǒȍȏȯ",
+	"R":  "ŔŖŘȐȒ",
+	"r":  "ŕŗřȑȓ",
+	"S":  "ŚŜŞŠȘ",
+	"s":  "śŝşšș",
+	"T":  "ŢŤŦȚ",
+	"t":  "ţťŧț",
+	"U":  "ÙÚÛÜŨŪŬŮŰŲǓǕǗǙǛȔȖ",
+	"u":  "ùúûüũūŭůűųǔǖǘǚǜȕȗ",
+	"W":  "Ŵ",
+	"w":  "ŵ",
+	"Y":  "ÝŶŸ",
+	"y":  "ýÿŷ",
+	"Z":  "ŹŻŽ",
+	"z":  "źżž",
+	"AE": "Æ",
+	"ae": "æ",
+	"OE": "Œ",
+	"oe": "œ",
+	"ss": "ß",
+}
+
+// accentFoldMap 是由 accentFoldTable 生成的 重音字母=>基础字母 映射。
+var accentFoldMap = func() map[rune]string {
+	m := make(map[rune]string)
+	for base, accents := range accentFoldTable {
+		for _, r := range accents {
+			m[r] = base
+		}
+	}
+	return m
+}()
+
+// RemoveAccents 移除字符串 `s` 中拉丁字母的重音符号，返回其基础形式，适用于搜索归一化或在 Slugify 之前处理。
+// 预组合的重音字母通过内置映射表转换（如 é -> e、ß -> ss），已分解的组合附加符号会被直接删除。
+// 非拉丁字符（如中文）保持不变。
+//
+// 示例：
+// RemoveAccents("Café Niño") -> "Cafe Nino"
+func RemoveAccents(s string) string {
+	var buffer strings.Builder
+	buffer.Grow(len(s))
+	for _, r := range s {
+		if r <= unicode.MaxASCII {
+			buffer.WriteRune(r)
+			continue
+		}
+		if base, ok := accentFoldMap[r]; ok {
+			buffer.WriteString(base)
+			continue
+		}
+		if isLatinCombiningMark(r) {
+			continue
+		}
+		buffer.WriteRune(r)
+	}
+	return buffer.String()
+}
+
+// isLatinCombiningMark 检查 `r` 是否为通用的组合附加符号（U+0300 - U+036F）。
+func isLatinCombiningMark(r rune) bool {
+	return r >= 0x0300 && r <= 0x036F
+}
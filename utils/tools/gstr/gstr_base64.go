@@ -0,0 +1,35 @@
+package gstr
+
+import (
+	"encoding/base64"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gbase64"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"strings"
+)
+
+// Base64Encode 使用标准 BASE64 编码字符串 `s`。
+func Base64Encode(s string) string {
+	return gbase64.EncodeString(s)
+}
+
+// Base64Decode 使用标准 BASE64 解码字符串 `s`。
+// 如果 `s` 不是合法的 BASE64 字符串，则返回错误。
+func Base64Decode(s string) (string, error) {
+	return gbase64.DecodeToString(s)
+}
+
+// Base64EncodeURL 使用 URL 安全的 BASE64 编码字符串 `s`，结果不包含填充字符 "="，
+// 可以直接用于 URL 和文件名中。
+func Base64EncodeURL(s string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// Base64DecodeURL 使用 URL 安全的 BASE64 解码字符串 `s`，`s` 可以包含也可以不包含填充字符 "="。
+// 如果 `s` 不是合法的 BASE64 字符串，则返回错误。
+func Base64DecodeURL(s string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return "", gerror.Wrap(err, `base64.RawURLEncoding.DecodeString failed`)
+	}
+	return string(b), nil
+}
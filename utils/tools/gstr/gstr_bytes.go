@@ -0,0 +1,94 @@
+package gstr
+
+import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// bytesUnitsSI 是十进制（SI，1000 进制）的字节单位。
+	bytesUnitsSI = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+	// bytesUnitsBinary 是二进制（IEC，1024 进制）的字节单位。
+	bytesUnitsBinary = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	// bytesUnitMultipliers 是 ParseBytes 支持的单位（小写）到字节数的映射。
+	bytesUnitMultipliers = map[string]float64{
+		"": 1, "b": 1,
+		"k": 1e3, "kb": 1e3, "ki": 1 << 10, "kib": 1 << 10,
+		"m": 1e6, "mb": 1e6, "mi": 1 << 20, "mib": 1 << 20,
+		"g": 1e9, "gb": 1e9, "gi": 1 << 30, "gib": 1 << 30,
+		"t": 1e12, "tb": 1e12, "ti": 1 << 40, "tib": 1 << 40,
+		"p": 1e15, "pb": 1e15, "pi": 1 << 50, "pib": 1 << 50,
+		"e": 1e18, "eb": 1e18, "ei": 1 << 60, "eib": 1 << 60,
+	}
+)
+
+// FormatBytes 将字节数 `bytes` 格式化为使用十进制（SI，1000 进制）单位的可读字符串，如 "1.5 MB"、"2 GB"。
+// 可选参数 `decimals` 指定最多保留的小数位数，默认为 2，末尾多余的 0 会被去掉。
+//
+// 示例：
+// FormatBytes(1500000) -> "1.5 MB"
+func FormatBytes(bytes int64, decimals ...int) string {
+	return formatBytes(bytes, 1000, bytesUnitsSI, decimals...)
+}
+
+// FormatBytesBinary 与 FormatBytes 相同，但使用二进制（IEC，1024 进制）单位，如 "1.5 MiB"。
+//
+// 示例：
+// FormatBytesBinary(1536) -> "1.5 KiB"
+func FormatBytesBinary(bytes int64, decimals ...int) string {
+	return formatBytes(bytes, 1024, bytesUnitsBinary, decimals...)
+}
+
+// formatBytes 是 FormatBytes 和 FormatBytesBinary 的内部实现。
+func formatBytes(bytes int64, base float64, units []string, decimals ...int) string {
+	precision := 2
+	if len(decimals) > 0 && decimals[0] >= 0 {
+		precision = decimals[0]
+	}
+	var (
+		value = math.Abs(float64(bytes))
+		index = 0
+	)
+	for value >= base && index < len(units)-1 {
+		value /= base
+		index++
+	}
+	s := strconv.FormatFloat(value, 'f', precision, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if bytes < 0 {
+		s = "-" + s
+	}
+	return s + " " + units[index]
+}
+
+// ParseBytes 将可读的字节大小字符串 `s` 解析为字节数，如 "1.5MB"、"2 GiB"、"512"。
+// 单位不区分大小写，KB/MB 等十进制单位按 1000 进制计算，KiB/MiB 等二进制单位按 1024 进制计算，
+// 无单位或单位为 B 时表示字节。无法解析时返回错误。
+func ParseBytes(s string) (int64, error) {
+	str := strings.TrimSpace(s)
+	i := 0
+	for ; i < len(str); i++ {
+		if (str[i] < '0' || str[i] > '9') && str[i] != '.' && !(i == 0 && (str[i] == '-' || str[i] == '+')) {
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(str[:i], 64)
+	if err != nil {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid byte size "%s"`, s)
+	}
+	multiplier, ok := bytesUnitMultipliers[strings.ToLower(strings.TrimSpace(str[i:]))]
+	if !ok {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid byte size unit in "%s"`, s)
+	}
+	result := number * multiplier
+	// float64(math.MaxInt64) rounds up to 2^63, which itself is out of range.
+	if result >= math.MaxInt64 || result < math.MinInt64 {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `byte size "%s" overflows int64`, s)
+	}
+	return int64(math.Round(result)), nil
+}
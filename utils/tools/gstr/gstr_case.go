@@ -16,6 +16,7 @@ package gstr
 import (
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // CaseType 是命名约定的类型。
@@ -33,6 +34,16 @@ const (
 	Lower           CaseType = "Lower"
 )
 
+var (
+	// acronyms 是 Title 和 HumanReadable 中需要保持大写的缩写词集合，键为小写形式。
+	acronyms = map[string]struct{}{
+		"api": {}, "html": {}, "http": {}, "https": {}, "id": {}, "ip": {},
+		"json": {}, "sql": {}, "uri": {}, "url": {}, "uuid": {}, "xml": {},
+	}
+	// acronymsMu 保证 acronyms 的并发安全。
+	acronymsMu sync.RWMutex
+)
+
 var (
 	numberSequence      = regexp.MustCompile(`([a-zA-Z]{0,1})(\d+)([a-zA-Z]{0,1})`)
 	firstCamelCaseStart = regexp.MustCompile(`([A-Z]+)([A-Z]?[_a-z\d]+)|$`)
@@ -61,6 +72,123 @@ func CaseTypeMatch(caseStr string) CaseType {
 	return CaseType(caseStr)
 }
 
+// DetectCase 根据字符串中的分隔符和大小写检测标识符 `s` 所使用的命名约定。
+// 无法区分的单个小写单词（以及空字符串）返回 Lower，
+// 不含分隔符的全大写单词视为 SnakeScreaming。
+//
+// Example:
+// DetectCase("any_kind_of_string") -> Snake
+// DetectCase("ANY_KIND_OF_STRING") -> SnakeScreaming
+// DetectCase("any-kind-of-string") -> Kebab
+// DetectCase("ANY-KIND-OF-STRING") -> KebabScreaming
+// DetectCase("AnyKindOfString")    -> Camel
+// DetectCase("anyKindOfString")    -> CamelLower
+// DetectCase("string")             -> Lower
+func DetectCase(s string) CaseType {
+	var (
+		hasUpper      bool
+		hasLower      bool
+		hasUnderscore bool
+		hasHyphen     bool
+	)
+	for _, v := range s {
+		switch {
+		case v >= 'A' && v <= 'Z':
+			hasUpper = true
+		case v >= 'a' && v <= 'z':
+			hasLower = true
+		case v == '_':
+			hasUnderscore = true
+		case v == '-':
+			hasHyphen = true
+		}
+	}
+	switch {
+	case hasUnderscore && !hasHyphen:
+		if hasUpper && !hasLower {
+			return SnakeScreaming
+		}
+		return Snake
+
+	case hasHyphen && !hasUnderscore:
+		if hasUpper && !hasLower {
+			return KebabScreaming
+		}
+		return Kebab
+
+	case hasUnderscore || hasHyphen || !hasUpper:
+		return Lower
+
+	case !hasLower:
+		return SnakeScreaming
+
+	case s[0] >= 'A' && s[0] <= 'Z':
+		return Camel
+
+	default:
+		return CamelLower
+	}
+}
+
+// RegisterAcronyms 注册在 Title 和 HumanReadable 中需要保持大写的缩写词，不区分大小写。
+// 默认已注册：API、HTML、HTTP、HTTPS、ID、IP、JSON、SQL、URI、URL、UUID、XML。
+func RegisterAcronyms(words ...string) {
+	acronymsMu.Lock()
+	defer acronymsMu.Unlock()
+	for _, word := range words {
+		acronyms[strings.ToLower(word)] = struct{}{}
+	}
+}
+
+// isAcronym 检查小写单词 `word` 是否为已注册的缩写词。
+func isAcronym(word string) bool {
+	acronymsMu.RLock()
+	defer acronymsMu.RUnlock()
+	_, ok := acronyms[word]
+	return ok
+}
+
+// caseWords 按下划线、中划线、点、空格以及驼峰边界将 `s` 拆分为小写单词。
+func caseWords(s string) []string {
+	return strings.Fields(CaseDelimited(s, ' '))
+}
+
+// Title 将标识符转换为每个单词首字母大写、以空格分隔的标题形式，
+// 已注册的缩写词保持全大写。
+//
+// Example:
+// Title("user_first_name") -> User First Name
+// Title("userProfileURL")  -> User Profile URL
+func Title(s string) string {
+	words := caseWords(s)
+	for i, word := range words {
+		if isAcronym(word) {
+			words[i] = strings.ToUpper(word)
+		} else {
+			words[i] = UcFirst(word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// HumanReadable 将标识符转换为以空格分隔的小写单词，仅首个单词首字母大写，
+// 已注册的缩写词保持全大写。
+//
+// Example:
+// HumanReadable("user_first_name") -> User first name
+// HumanReadable("api-user-id")     -> API user ID
+func HumanReadable(s string) string {
+	words := caseWords(s)
+	for i, word := range words {
+		if isAcronym(word) {
+			words[i] = strings.ToUpper(word)
+		} else if i == 0 {
+			words[i] = UcFirst(word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
 // CaseConvert 将字符串转换为指定的命名约定。
 // 使用 CaseTypeMatch 从字符串中匹配命名约定类型。
 func CaseConvert(s string, caseType CaseType) string {
@@ -107,6 +235,30 @@ func CaseCamel(s string) string {
 	return toCamelInitCase(s, true)
 }
 
+// CaseCamelAcronyms 将字符串转换为 CamelCase 命名约定，其中的缩写词单词保持全大写，适用于生成 Go 标识符。
+// 缩写词包括通过 RegisterAcronyms 注册的缩写词（默认包含 ID、URL、API、HTTP、JSON、UUID 等），
+// 以及本次调用额外传入的 `acronyms`（不区分大小写，不会被注册）。
+//
+// Example:
+// CaseCamelAcronyms("user_id")         -> UserID
+// CaseCamelAcronyms("api_url")         -> APIURL
+// CaseCamelAcronyms("sku_code", "sku") -> SKUCode
+func CaseCamelAcronyms(s string, acronyms ...string) string {
+	extra := make(map[string]struct{}, len(acronyms))
+	for _, acronym := range acronyms {
+		extra[strings.ToLower(acronym)] = struct{}{}
+	}
+	words := caseWords(s)
+	for i, word := range words {
+		if _, ok := extra[word]; ok || isAcronym(word) {
+			words[i] = strings.ToUpper(word)
+		} else {
+			words[i] = UcFirst(word)
+		}
+	}
+	return strings.Join(words, "")
+}
+
 // CaseCamelLower 将字符串转换为 lowerCamelCase 命名约定。
 //
 // Example:
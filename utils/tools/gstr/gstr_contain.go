@@ -19,3 +19,49 @@ func ContainsI(str, substr string) bool {
 func ContainsAny(s, chars string) bool {
 	return strings.ContainsAny(s, chars)
 }
+
+// ContainsAnyOf 检查字符串 `s` 中是否包含 `needles` 中的任意一个子字符串。
+// 如果 `needles` 为空，则返回 false。与 strings.Contains 相同，空字符串视为包含于任意字符串中。
+func ContainsAnyOf(s string, needles []string) bool {
+	for _, needle := range needles {
+		if strings.Contains(s, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAnyOfI 检查字符串 `s` 中是否包含 `needles` 中的任意一个子字符串，不区分大小写。
+// 如果 `needles` 为空，则返回 false。
+func ContainsAnyOfI(s string, needles []string) bool {
+	s = strings.ToLower(s)
+	for _, needle := range needles {
+		if strings.Contains(s, strings.ToLower(needle)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAllOf 检查字符串 `s` 中是否包含 `needles` 中的所有子字符串。
+// 如果 `needles` 为空，则返回 true。与 strings.Contains 相同，空字符串视为包含于任意字符串中。
+func ContainsAllOf(s string, needles []string) bool {
+	for _, needle := range needles {
+		if !strings.Contains(s, needle) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAllOfI 检查字符串 `s` 中是否包含 `needles` 中的所有子字符串，不区分大小写。
+// 如果 `needles` 为空，则返回 true。
+func ContainsAllOfI(s string, needles []string) bool {
+	s = strings.ToLower(s)
+	for _, needle := range needles {
+		if !strings.Contains(s, strings.ToLower(needle)) {
+			return false
+		}
+	}
+	return true
+}
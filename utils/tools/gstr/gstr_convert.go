@@ -109,6 +109,59 @@ func NumberFormat(number float64, decimals int, decPoint, thousandsSep string) s
 	return s
 }
 
+// currencyFormat 是货币的格式化规则。
+type currencyFormat struct {
+	symbol       string // 货币符号。
+	decimals     int    // 默认小数位数。
+	decPoint     string // 小数点分隔符。
+	thousandsSep string // 千位分隔符。
+	suffix       bool   // 货币符号是否位于数字之后（以空格分隔）。
+}
+
+// currencyFormats 是内置的常用货币格式化规则，键为大写的 ISO 4217 货币代码。
+var currencyFormats = map[string]currencyFormat{
+	"USD": {symbol: "$", decimals: 2, decPoint: ".", thousandsSep: ","},
+	"CNY": {symbol: "¥", decimals: 2, decPoint: ".", thousandsSep: ","},
+	"JPY": {symbol: "¥", decimals: 0, decPoint: ".", thousandsSep: ","},
+	"GBP": {symbol: "£", decimals: 2, decPoint: ".", thousandsSep: ","},
+	"HKD": {symbol: "HK$", decimals: 2, decPoint: ".", thousandsSep: ","},
+	"EUR": {symbol: "€", decimals: 2, decPoint: ",", thousandsSep: ".", suffix: true},
+	"RUB": {symbol: "₽", decimals: 2, decPoint: ",", thousandsSep: " ", suffix: true},
+}
+
+// FormatMoney 按货币代码 `currency` 的格式规则将金额 `amount` 格式化为字符串。
+// 可选参数 `decimals` 用于指定小数位数，默认使用货币的小数位数。
+// 未知的货币代码使用代码本身作为前缀符号，并使用 "." 和 "," 作为小数点和千位分隔符。
+//
+// Example:
+// FormatMoney(1234.56, "USD") -> $1,234.56
+// FormatMoney(1234.56, "EUR") -> 1.234,56 €
+// FormatMoney(1234.56, "XYZ") -> XYZ 1,234.56
+func FormatMoney(amount float64, currency string, decimals ...int) string {
+	format, ok := currencyFormats[strings.ToUpper(currency)]
+	if !ok {
+		format = currencyFormat{
+			symbol:       currency + " ",
+			decimals:     2,
+			decPoint:     ".",
+			thousandsSep: ",",
+		}
+	}
+	if len(decimals) > 0 {
+		format.decimals = decimals[0]
+	}
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	number := NumberFormat(amount, format.decimals, format.decPoint, format.thousandsSep)
+	if format.suffix {
+		return sign + number + " " + format.symbol
+	}
+	return sign + format.symbol + number
+}
+
 // Shuffle 将字符串 `str` 随机打乱并返回。
 // 它考虑参数 `str` 为 Unicode 字符串。
 //
@@ -266,6 +319,52 @@ func WordWrap(str string, width int, br string) string {
 	return buf.String()
 }
 
+// WrapLines 将字符串 `str` 按宽度 `width`（按 rune 计算）拆分为多行并以切片返回，适用于终端渲染。
+// 优先在空白处断行，单词长度超过 `width` 时会被强制截断；输入中已有的换行符会强制换行，
+// 连续的空白会被合并为一个空格。`width` <= 0 时只按已有换行符拆分。
+//
+// 示例：
+// WrapLines("hello world foo", 11) -> []string{"hello world", "foo"}
+func WrapLines(str string, width int) []string {
+	var (
+		lines      = make([]string, 0)
+		paragraphs = strings.Split(strings.ReplaceAll(str, "\r\n", "\n"), "\n")
+	)
+	if width <= 0 {
+		return paragraphs
+	}
+	for _, paragraph := range paragraphs {
+		var (
+			current []rune
+			words   = strings.Fields(paragraph)
+		)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		for _, word := range words {
+			wordRunes := []rune(word)
+			switch {
+			case len(current) == 0:
+			case len(current)+1+len(wordRunes) <= width:
+				current = append(current, ' ')
+				current = append(current, wordRunes...)
+				continue
+			default:
+				lines = append(lines, string(current))
+			}
+			// 超长单词按宽度强制截断，最后一段作为当前行继续拼接。
+			for len(wordRunes) > width {
+				lines = append(lines, string(wordRunes[:width]))
+				wordRunes = wordRunes[width:]
+			}
+			current = wordRunes
+		}
+		lines = append(lines, string(current))
+	}
+	return lines
+}
+
 func isPunctuation(char int32) bool {
 	switch char {
 	// English Punctuations.
@@ -278,3 +377,64 @@ func isPunctuation(char int32) bool {
 		return false
 	}
 }
+
+// KeepAlphaNumeric 只保留字符串 `s` 中的字母和数字（包含 Unicode 字母），删除其他所有字符。
+func KeepAlphaNumeric(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// RemoveSymbols 删除字符串 `s` 中的标点和符号字符，保留字母、数字和空白字符。
+func RemoveSymbols(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Slugify 将字符串 `s` 转换为 URL 友好的 slug 形式：
+// 转为小写，将连续的非字母数字字符替换为分隔符 `sep`（默认为 "-"），并去除首尾的分隔符。
+// 它会保留 Unicode 字母，如只需保留 ASCII 字符请使用 SlugifyASCII。
+func Slugify(s string, sep ...string) string {
+	return slugify(s, false, sep...)
+}
+
+// SlugifyASCII 与 Slugify 相同，但只保留 ASCII 字母和数字。
+func SlugifyASCII(s string, sep ...string) string {
+	return slugify(s, true, sep...)
+}
+
+// slugify 是 Slugify 和 SlugifyASCII 的内部实现。
+func slugify(s string, asciiOnly bool, sep ...string) string {
+	separator := "-"
+	if len(sep) > 0 {
+		separator = sep[0]
+	}
+	var (
+		buffer  = bytes.NewBuffer(nil)
+		pending = false
+	)
+	for _, r := range strings.ToLower(s) {
+		isAlphaNumeric := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if asciiOnly && r > unicode.MaxASCII {
+			isAlphaNumeric = false
+		}
+		if !isAlphaNumeric {
+			pending = true
+			continue
+		}
+		// 只在两个有效字符之间写入分隔符，从而不会出现首尾分隔符。
+		if pending && buffer.Len() > 0 {
+			buffer.WriteString(separator)
+		}
+		pending = false
+		buffer.WriteRune(r)
+	}
+	return buffer.String()
+}
@@ -2,6 +2,7 @@ package gstr
 
 import (
 	"bytes"
+	"sort"
 	"strings"
 	"unicode"
 )
@@ -39,6 +40,34 @@ func CountWords(str string) map[string]int {
 	return m
 }
 
+// WordCount 是 TopWords 返回的单词及其出现次数。
+type WordCount struct {
+	Word  string // 单词。
+	Count int    // 出现次数。
+}
+
+// TopWords 返回字符串 `str` 中出现次数最多的 `n` 个单词，单词按空白字符分隔，统计规则同 CountWords。
+// 结果按出现次数降序排列，次数相同时按单词字典序升序排列；`n` <= 0 时返回所有单词。
+func TopWords(str string, n int) []WordCount {
+	var (
+		counts = CountWords(str)
+		words  = make([]WordCount, 0, len(counts))
+	)
+	for word, count := range counts {
+		words = append(words, WordCount{Word: word, Count: count})
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if words[i].Count != words[j].Count {
+			return words[i].Count > words[j].Count
+		}
+		return words[i].Word < words[j].Word
+	})
+	if n > 0 && n < len(words) {
+		words = words[:n]
+	}
+	return words
+}
+
 // CountChars 返回字符串 `str` 中字符的数量。
 // 如果参数 `noSpace` 为 true，则不统计空格字符。
 // 它考虑参数 `str` 为 Unicode 字符串。
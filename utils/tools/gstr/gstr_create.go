@@ -3,9 +3,25 @@ package gstr
 import "strings"
 
 // Repeat 返回由字符串 `input` 重复 `multiplier` 次组成的新字符串。
+// 如果 `multiplier` <= 0，则返回空字符串。
 //
 // 示例：
 // Repeat("a", 3) -> "aaa"
 func Repeat(input string, multiplier int) string {
+	if multiplier <= 0 {
+		return ""
+	}
 	return strings.Repeat(input, multiplier)
 }
+
+// RepeatJoin 返回由 `count` 个字符串 `input` 使用 `sep` 连接组成的新字符串。
+// 如果 `count` <= 0，则返回空字符串。
+//
+// 示例：
+// RepeatJoin("?", ",", 3) -> "?,?,?"
+func RepeatJoin(input, sep string, count int) string {
+	if count <= 0 {
+		return ""
+	}
+	return strings.Repeat(input+sep, count-1) + input
+}
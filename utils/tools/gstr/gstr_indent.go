@@ -0,0 +1,131 @@
+package gstr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Indent 为字符串 `s` 的每一行添加前缀 `prefix`，同时支持 `\n` 与 `\r\n` 换行符。
+// 默认跳过空白行（仅包含空白字符的行），可选参数 `indentBlank` 为 true 时空白行也会添加前缀。
+// 末尾换行符之后的空串不视为一行，不会添加前缀。
+//
+// 示例：
+// Indent("a\nb\n", "  ") -> "  a\n  b\n"
+func Indent(s, prefix string, indentBlank ...bool) string {
+	if s == "" || prefix == "" {
+		return s
+	}
+	withBlank := len(indentBlank) > 0 && indentBlank[0]
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if i == len(lines)-1 && line == "" {
+			break
+		}
+		if !withBlank && isBlankLine(line) {
+			continue
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Dedent 移除字符串 `s` 所有行共同的最长前导空白，同时支持 `\n` 与 `\r\n` 换行符。
+// 空白行不参与公共前缀的计算，并会被规范化为空行（保留 `\r\n` 换行符）。
+//
+// 示例：
+// Dedent("    a\n      b\n") -> "a\n  b\n"
+func Dedent(s string) string {
+	if s == "" {
+		return s
+	}
+	var (
+		lines  = strings.Split(s, "\n")
+		margin string
+		found  bool
+	)
+	for _, line := range lines {
+		if isBlankLine(line) {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !found {
+			margin, found = indent, true
+			continue
+		}
+		margin = commonPrefix(margin, indent)
+		if margin == "" {
+			break
+		}
+	}
+	for i, line := range lines {
+		if isBlankLine(line) {
+			if strings.HasSuffix(line, "\r") {
+				lines[i] = "\r"
+			} else {
+				lines[i] = ""
+			}
+			continue
+		}
+		lines[i] = strings.TrimPrefix(line, margin)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NumberLines 为字符串 `text` 的每一行添加右对齐的行号和分隔符 " | "，同时支持 `\n` 与 `\r\n` 换行符。
+// 行号默认从 1 开始，可通过可选参数 `startAt` 指定起始行号；所有行号按最大行号的位数左侧补空格对齐。
+// 末尾换行符之后的空串不视为一行，不会添加行号。
+//
+// 示例：
+// NumberLines("a\nb\n")  -> "1 | a\n2 | b\n"
+// NumberLines("a\nb", 9) -> " 9 | a\n10 | b"
+func NumberLines(text string, startAt ...int) string {
+	if text == "" {
+		return text
+	}
+	start := 1
+	if len(startAt) > 0 {
+		start = startAt[0]
+	}
+	lines := strings.Split(text, "\n")
+	count := len(lines)
+	if lines[count-1] == "" {
+		count--
+	}
+	var (
+		width  = len(strconv.Itoa(start + count - 1))
+		buffer strings.Builder
+	)
+	if w := len(strconv.Itoa(start)); w > width {
+		width = w
+	}
+	for i := 0; i < count; i++ {
+		number := strconv.Itoa(start + i)
+		buffer.WriteString(strings.Repeat(" ", width-len(number)))
+		buffer.WriteString(number)
+		buffer.WriteString(" | ")
+		buffer.WriteString(lines[i])
+		if i < len(lines)-1 {
+			buffer.WriteString("\n")
+		}
+	}
+	return buffer.String()
+}
+
+// isBlankLine 判断一行是否为空白行（为空或只包含空白字符）。
+func isBlankLine(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// commonPrefix 返回字符串 `a` 与 `b` 的最长公共前缀。
+func commonPrefix(a, b string) string {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return a[:i]
+		}
+	}
+	return a[:n]
+}
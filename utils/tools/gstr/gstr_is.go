@@ -1,8 +1,62 @@
 package gstr
 
-import "github.com/dwrui/go-zero-admin/pkg/utils/tools/utils"
+import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/utils"
+	"net"
+	"net/url"
+	"regexp"
+	"unicode"
+)
+
+// emailRegex 是用于 IsEmail 的简化邮箱格式正则，允许不带点号的域名（如 localhost）。
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9](?:[a-zA-Z0-9\-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9\-]*[a-zA-Z0-9])?)*$`)
 
 // IsNumeric tests whether the given string s is numeric.
 func IsNumeric(s string) bool {
 	return utils.IsNumeric(s)
 }
+
+// IsEmail 检查字符串 `s` 是否为合法的邮箱地址格式，空字符串返回 false。
+func IsEmail(s string) bool {
+	return s != "" && emailRegex.MatchString(s)
+}
+
+// IsURL 检查字符串 `s` 是否为包含协议和主机的合法 URL，空字符串返回 false。
+func IsURL(s string) bool {
+	if s == "" {
+		return false
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return u.Scheme != "" && u.Host != ""
+}
+
+// IsIP 检查字符串 `s` 是否为合法的 IPv4 或 IPv6 地址，空字符串返回 false。
+func IsIP(s string) bool {
+	return s != "" && net.ParseIP(s) != nil
+}
+
+// IsBlank 检查字符串 `s` 是否为空或只包含空白字符（支持 Unicode 空白字符，如全角空格）。
+func IsBlank(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNotBlank 检查字符串 `s` 是否包含非空白字符，与 IsBlank 相反。
+func IsNotBlank(s string) bool {
+	return !IsBlank(s)
+}
+
+// DefaultIfBlank 如果字符串 `s` 为空或只包含空白字符，则返回默认值 `def`，否则原样返回 `s`。
+func DefaultIfBlank(s, def string) string {
+	if IsBlank(s) {
+		return def
+	}
+	return s
+}
@@ -6,3 +6,23 @@ import "unicode/utf8"
 func LenRune(str string) int {
 	return utf8.RuneCountInString(str)
 }
+
+// RuneLen 返回字符串 `s` 的 Unicode 字符（rune）数量，与 LenRune 等价。
+func RuneLen(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// ByteLen 返回字符串 `s` 的字节长度，等价于 len(s)。
+func ByteLen(s string) int {
+	return len(s)
+}
+
+// IsMultiByte 检查字符串 `s` 是否包含多字节字符（非 ASCII 字符）。
+func IsMultiByte(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,7 @@
 package gstr
 
+import "strings"
+
 // List2 将字符串 `str` 以 `delimiter` 分隔，并返回结果的前两个部分。
 func List2(str, delimiter string) (part1, part2 string) {
 	return doList2(delimiter, Split(str, delimiter))
@@ -47,3 +49,37 @@ func doList3(delimiter string, array []string) (part1, part2, part3 string) {
 		return array[0], array[1], Join(array[2:], delimiter)
 	}
 }
+
+// ParseKeyValue 将字符串 `s` 以第一个 `sep` 分隔为键和值，并去掉两者的首尾空白，如 "key: value"、"key=value"。
+// 值中可以包含 `sep`。如果 `s` 中不包含 `sep` 或键为空，则 `ok` 返回 false。
+func ParseKeyValue(s, sep string) (key, value string, ok bool) {
+	if sep == "" {
+		return "", "", false
+	}
+	pos := strings.Index(s, sep)
+	if pos == NotFoundIndex {
+		return "", "", false
+	}
+	key = strings.TrimSpace(s[:pos])
+	value = strings.TrimSpace(s[pos+len(sep):])
+	if key == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// ParseKeyValueLines 按行使用 ParseKeyValue 解析多行文本 `text`，返回键值映射。
+// 空白行、以 `#` 开头的注释行以及无法解析的行会被忽略，重复的键以最后出现的为准。
+func ParseKeyValueLines(text, sep string) map[string]string {
+	result := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if key, value, ok := ParseKeyValue(line, sep); ok {
+			result[key] = value
+		}
+	}
+	return result
+}
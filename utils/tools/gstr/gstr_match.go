@@ -0,0 +1,110 @@
+package gstr
+
+// Match 检查字符串 `s` 是否完整匹配通配符模式 `pattern`，不需要编译正则表达式，适用于 "user:*:profile" 这类键的匹配。
+// 支持以下语法：
+//
+//   - `*` 匹配任意长度（包括 0）的任意字符；
+//   - `?` 匹配任意单个字符；
+//   - `[abc]` 匹配字符集合中的任意单个字符，支持 `[a-z]` 范围以及 `[!abc]`、`[^abc]` 取反；
+//   - `\x` 匹配字面字符 x，用于转义 `*`、`?`、`[` 和 `\` 等特殊字符。
+//
+// 匹配时以字符（rune）为单位，并且模式需要匹配整个字符串。未闭合的 `[` 按字面字符处理。
+//
+// 示例：
+// Match("user:*:profile", "user:42:profile") -> true
+// Match("file?.txt", "file10.txt")          -> false
+func Match(pattern, s string) bool {
+	var (
+		p         = []rune(pattern)
+		str       = []rune(s)
+		pi, si    int
+		starPi    = -1 // starPi 是最近一个 * 在模式中的位置。
+		starMatch int  // starMatch 是最近一个 * 已匹配到的字符串位置。
+	)
+	for si < len(str) {
+		if pi < len(p) {
+			if p[pi] == '*' {
+				starPi, starMatch = pi, si
+				pi++
+				continue
+			}
+			if matched, next := matchOne(p, pi, str[si]); matched {
+				pi, si = next, si+1
+				continue
+			}
+		}
+		// 当前字符不匹配时，回溯到最近的 * 并让其多匹配一个字符。
+		if starPi < 0 {
+			return false
+		}
+		starMatch++
+		pi, si = starPi+1, starMatch
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+// MatchAny 检查字符串 `s` 是否匹配 `patterns` 中的任意一个通配符模式，语法同 Match。
+func MatchAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchOne 检查字符 `r` 是否匹配模式 `p` 中从 `pi` 开始的单个字符模式（不包括 *），
+// 并返回该字符模式之后的位置。
+func matchOne(p []rune, pi int, r rune) (matched bool, next int) {
+	switch p[pi] {
+	case '?':
+		return true, pi + 1
+	case '\\':
+		if pi+1 < len(p) {
+			return p[pi+1] == r, pi + 2
+		}
+	case '[':
+		if matched, next, ok := matchClass(p, pi, r); ok {
+			return matched, next
+		}
+	}
+	return p[pi] == r, pi + 1
+}
+
+// matchClass 检查字符 `r` 是否匹配模式 `p` 中从 `pi` 开始的字符集合，并返回字符集合之后的位置。
+// 如果字符集合没有闭合，则 `ok` 为 false。
+func matchClass(p []rune, pi int, r rune) (matched bool, next int, ok bool) {
+	i := pi + 1
+	negate := i < len(p) && (p[i] == '!' || p[i] == '^')
+	if negate {
+		i++
+	}
+	for first := true; i < len(p); first = false {
+		// 紧跟在 [ 之后的 ] 视为字面字符。
+		if p[i] == ']' && !first {
+			return matched != negate, i + 1, true
+		}
+		low := p[i]
+		if low == '\\' && i+1 < len(p) {
+			i++
+			low = p[i]
+		}
+		high := low
+		i++
+		if i+1 < len(p) && p[i] == '-' && p[i+1] != ']' {
+			high = p[i+1]
+			if high == '\\' && i+2 < len(p) {
+				i++
+				high = p[i+1]
+			}
+			i += 2
+		}
+		if low <= r && r <= high {
+			matched = true
+		}
+	}
+	return false, pi + 1, false
+}
@@ -91,6 +91,35 @@ func Parse(s string) (result map[string]interface{}, err error) {
 	return result, nil
 }
 
+// BuildQuery builds URL query string from `params`, like "a=1&b=x+y".
+// Keys and values are URL-encoded by net/url and keys are sorted for deterministic output.
+// It returns empty string if `params` is empty.
+func BuildQuery(params map[string]string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	values := make(url.Values, len(params))
+	for k, v := range params {
+		values.Set(k, v)
+	}
+	return values.Encode()
+}
+
+// ParseQuery parses URL query string `query` into map[string]string, decoding keys and values.
+// A leading "?" is ignored, only the first value is kept for repeated keys,
+// and pairs that fail to be decoded are skipped.
+// Unlike Parse, it does not handle nested keys like "v[a]".
+func ParseQuery(query string) map[string]string {
+	values, _ := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	result := make(map[string]string, len(values))
+	for k, v := range values {
+		if len(v) > 0 {
+			result[k] = v[0]
+		}
+	}
+	return result
+}
+
 // build nested map.
 func build(result map[string]interface{}, keys []string, value interface{}) error {
 	var (
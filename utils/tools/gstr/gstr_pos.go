@@ -1,6 +1,9 @@
 package gstr
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // Pos 返回字符串 `haystack` 中第一次出现 `needle` 的位置，从 `startOffset` 开始搜索。
 // 如果未找到，则返回 -1。
@@ -33,6 +36,52 @@ func PosRune(haystack, needle string, startOffset ...int) int {
 	return len([]rune(haystack[:pos]))
 }
 
+// PosAll 返回字符串 `haystack` 中所有不重叠出现 `needle` 的字节位置。
+// 如果未找到或 `needle` 为空，则返回空切片。
+func PosAll(haystack, needle string) []int {
+	positions := make([]int, 0)
+	if needle == "" {
+		return positions
+	}
+	for offset := 0; offset < len(haystack); {
+		pos := Pos(haystack, needle, offset)
+		if pos == NotFoundIndex {
+			break
+		}
+		positions = append(positions, pos)
+		offset = pos + len(needle)
+	}
+	return positions
+}
+
+// PosAllRune 与 PosAll 相同，但返回的是字符（rune）位置而非字节位置。
+func PosAllRune(haystack, needle string) []int {
+	var (
+		positions = PosAll(haystack, needle)
+		lastByte  = 0
+		lastRune  = 0
+	)
+	for i, pos := range positions {
+		lastRune += utf8.RuneCountInString(haystack[lastByte:pos])
+		lastByte = pos
+		positions[i] = lastRune
+	}
+	return positions
+}
+
+// RunePos 返回字符 `r` 在字符串 `s` 中第一次出现的字符（rune）索引，而非字节索引。
+// 如果未找到，则返回 -1。
+func RunePos(s string, r rune) int {
+	index := 0
+	for _, c := range s {
+		if c == r {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
 // PosI 返回字符串 `haystack` 中第一次出现 `needle` 的位置，从 `startOffset` 开始搜索，不区分大小写。
 // 如果未找到，则返回 -1。
 func PosI(haystack, needle string, startOffset ...int) int {
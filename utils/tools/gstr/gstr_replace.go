@@ -2,7 +2,10 @@ package gstr
 
 import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/utils"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Replace 返回字符串 `origin` 的副本，
@@ -47,6 +50,133 @@ func ReplaceI(origin, search, replace string, count ...int) string {
 	return origin
 }
 
+// ReplaceFirst 返回字符串 `origin` 的副本，其中第一次出现的 `search` 被 `replace` 替换，区分大小写。
+// 等同于 Replace(origin, search, replace, 1)。
+func ReplaceFirst(origin, search, replace string) string {
+	return Replace(origin, search, replace, 1)
+}
+
+// ReplaceLast 返回字符串 `origin` 的副本，其中最后一次出现的 `search` 被 `replace` 替换，区分大小写。
+// 如果 `search` 为空或未找到，则原样返回 `origin`。
+//
+// 示例：
+// ReplaceLast("a-b-c", "-", "+") -> "a-b+c"
+func ReplaceLast(origin, search, replace string) string {
+	if search == "" {
+		return origin
+	}
+	pos := PosR(origin, search)
+	if pos == -1 {
+		return origin
+	}
+	return origin[:pos] + replace + origin[pos+len(search):]
+}
+
+// ReplaceLastI 与 ReplaceLast 相同，但查找 `search` 时不区分大小写。
+// 按 Unicode 字符逐个比较，大小写形式的字节长度不同时也能正确替换。
+func ReplaceLastI(origin, search, replace string) string {
+	if search == "" {
+		return origin
+	}
+	for i := len(origin); i > 0; {
+		_, size := utf8.DecodeLastRuneInString(origin[:i])
+		i -= size
+		if n, ok := hasPrefixFold(origin[i:], search); ok {
+			return origin[:i] + replace + origin[i+n:]
+		}
+	}
+	return origin
+}
+
+// hasPrefixFold 检查 `s` 是否以 `prefix` 开头（不区分大小写），并返回 `s` 中匹配部分的字节长度。
+func hasPrefixFold(s, prefix string) (n int, ok bool) {
+	for prefix != "" {
+		if n >= len(s) {
+			return 0, false
+		}
+		r1, size1 := utf8.DecodeRuneInString(s[n:])
+		r2, size2 := utf8.DecodeRuneInString(prefix)
+		if r1 != r2 && !strings.EqualFold(string(r1), string(r2)) {
+			return 0, false
+		}
+		n += size1
+		prefix = prefix[size2:]
+	}
+	return n, true
+}
+
+// ReplaceWord 返回字符串 `origin` 的副本，其中作为完整单词出现的 `search` 被 `replace` 替换，区分大小写。
+// 完整单词指 `search` 的两侧是非单词字符（字母、数字和下划线以外的字符）或字符串边界。
+//
+// 示例：
+// ReplaceWord("the cat sat in category", "cat", "dog") -> "the dog sat in category"
+func ReplaceWord(origin, search, replace string) string {
+	return replaceWord(origin, search, replace, false)
+}
+
+// ReplaceWordI 返回字符串 `origin` 的副本，其中作为完整单词出现的 `search` 被 `replace` 替换，不区分大小写。
+// 也请参阅 ReplaceWord。
+func ReplaceWordI(origin, search, replace string) string {
+	return replaceWord(origin, search, replace, true)
+}
+
+// replaceWord 是 ReplaceWord 和 ReplaceWordI 的内部实现。
+func replaceWord(origin, search, replace string, ignoreCase bool) string {
+	if search == "" {
+		return origin
+	}
+	var (
+		buffer  strings.Builder
+		length  = len(search)
+		last    = 0
+		matched bool
+	)
+	for i := 0; i+length <= len(origin); {
+		if ignoreCase {
+			matched = strings.EqualFold(origin[i:i+length], search)
+		} else {
+			matched = origin[i:i+length] == search
+		}
+		if matched && isWordBoundaryBefore(origin[:i]) && isWordBoundaryAfter(origin[i+length:]) {
+			buffer.WriteString(origin[last:i])
+			buffer.WriteString(replace)
+			i += length
+			last = i
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(origin[i:])
+		i += size
+	}
+	if last == 0 {
+		return origin
+	}
+	buffer.WriteString(origin[last:])
+	return buffer.String()
+}
+
+// isWordBoundaryBefore 检查 `s` 的末尾是否为单词边界。
+func isWordBoundaryBefore(s string) bool {
+	if s == "" {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return !isWordRune(r)
+}
+
+// isWordBoundaryAfter 检查 `s` 的开头是否为单词边界。
+func isWordBoundaryAfter(s string) bool {
+	if s == "" {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return !isWordRune(r)
+}
+
+// isWordRune 检查 `r` 是否为单词字符，即字母、数字或下划线。
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
 // ReplaceByArray 返回字符串 `origin` 的副本，
 // 其中字符串 `search` 被 `replace` 替换，区分大小写。
 func ReplaceByArray(origin string, array []string) string {
@@ -177,3 +307,136 @@ func ReplaceIFunc(origin string, search string, f func(string) string) string {
 	}
 	return result.String()
 }
+
+// ReplaceTemplate 返回模板 `tpl` 的副本，其中形如 `{key}` 和 `${key}` 的占位符
+// 被 `vars` 中对应的值替换。
+// 与 ReplaceByMap 不同，它只替换可识别的占位符，不会误替换普通文本；
+// `vars` 中不存在的占位符保持原样。
+// 使用 `{{` 和 `}}` 可以分别输出字面量 `{` 和 `}`。
+//
+// Example:
+// ReplaceTemplate("Hello {name}, ${age}", map[string]string{"name": "john", "age": "18"}) -> Hello john, 18
+// ReplaceTemplate("{{name}} {unknown}", map[string]string{"name": "john"})             -> {name} {unknown}
+func ReplaceTemplate(tpl string, vars map[string]string) string {
+	var (
+		result strings.Builder
+		length = len(tpl)
+	)
+	result.Grow(length)
+	for i := 0; i < length; {
+		switch {
+		case tpl[i] == '{' && i+1 < length && tpl[i+1] == '{':
+			result.WriteByte('{')
+			i += 2
+			continue
+
+		case tpl[i] == '}' && i+1 < length && tpl[i+1] == '}':
+			result.WriteByte('}')
+			i += 2
+			continue
+
+		case tpl[i] == '$' && i+1 < length && tpl[i+1] == '{':
+			if value, end, ok := templatePlaceholder(tpl, i+1, vars); ok {
+				result.WriteString(value)
+				i = end
+				continue
+			}
+
+		case tpl[i] == '{':
+			if value, end, ok := templatePlaceholder(tpl, i, vars); ok {
+				result.WriteString(value)
+				i = end
+				continue
+			}
+		}
+		result.WriteByte(tpl[i])
+		i++
+	}
+	return result.String()
+}
+
+// templatePlaceholder 解析 `tpl` 中从 `start` 位置（即 `{` 所在位置）开始的占位符，
+// 返回替换值以及占位符之后的位置，占位符无法识别或不在 `vars` 中时 ok 为 false。
+func templatePlaceholder(tpl string, start int, vars map[string]string) (value string, end int, ok bool) {
+	closePos := strings.IndexAny(tpl[start+1:], "{}")
+	if closePos == -1 || tpl[start+1+closePos] != '}' {
+		return "", 0, false
+	}
+	key := tpl[start+1 : start+1+closePos]
+	if value, ok = vars[key]; !ok {
+		return "", 0, false
+	}
+	return value, start + closePos + 2, true
+}
+
+// Highlight 不区分大小写地查找字符串 `text` 中出现的每个 `terms`，并使用 `prefix` 和 `suffix` 将其包裹，
+// 常用于在搜索结果中标记匹配的关键字，如使用 "<mark>" 和 "</mark>"。
+// 被包裹的内容保留 `text` 中的原始大小写。从左到右匹配，同一位置优先匹配最长的关键字，
+// 已被包裹的内容不会再次匹配，因此重叠或嵌套的关键字不会产生重复包裹。空关键字会被忽略。
+//
+// 注意：`prefix` 和 `suffix` 会原样插入，`text` 中的内容不会被转义。
+//
+// 示例：
+// Highlight("Go and Golang", []string{"go", "golang"}, "<b>", "</b>") -> "<b>Go</b> and <b>Golang</b>"
+func Highlight(text string, terms []string, prefix, suffix string) string {
+	var (
+		runes      = []rune(text)
+		lowerText  = lowerRunes(runes)
+		lowerTerms = make([][]rune, 0, len(terms))
+		buffer     strings.Builder
+	)
+	for _, term := range terms {
+		if term != "" {
+			lowerTerms = append(lowerTerms, lowerRunes([]rune(term)))
+		}
+	}
+	if len(lowerTerms) == 0 {
+		return text
+	}
+	// 按长度降序排列，使同一位置优先匹配最长的关键字。
+	sort.SliceStable(lowerTerms, func(i, j int) bool {
+		return len(lowerTerms[i]) > len(lowerTerms[j])
+	})
+	buffer.Grow(len(text))
+	for i := 0; i < len(runes); {
+		matched := 0
+		for _, term := range lowerTerms {
+			if hasRunePrefix(lowerText[i:], term) {
+				matched = len(term)
+				break
+			}
+		}
+		if matched == 0 {
+			buffer.WriteRune(runes[i])
+			i++
+			continue
+		}
+		buffer.WriteString(prefix)
+		buffer.WriteString(string(runes[i : i+matched]))
+		buffer.WriteString(suffix)
+		i += matched
+	}
+	return buffer.String()
+}
+
+// lowerRunes 返回 `runes` 中每个字符转换为小写后的副本，长度与 `runes` 相同。
+func lowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+// hasRunePrefix 检查 `runes` 是否以 `prefix` 开头。
+func hasRunePrefix(runes, prefix []rune) bool {
+	if len(prefix) > len(runes) {
+		return false
+	}
+	for i, r := range prefix {
+		if runes[i] != r {
+			return false
+		}
+	}
+	return true
+}
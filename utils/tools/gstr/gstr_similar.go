@@ -1,5 +1,11 @@
 package gstr
 
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
 // Levenshtein 计算两个字符串之间的 Levenshtein 距离。
 // costIns: 定义插入的成本。
 // costRep: 定义替换的成本。
@@ -150,3 +156,55 @@ func Soundex(str string) string {
 	}
 	return string(sd)
 }
+
+// RelevanceScore 计算候选字符串 `candidate` 与查询字符串 `query` 的相关度，返回 0 到 1 之间的分数，适用于自动补全结果排序。
+// 不区分大小写地包含 `query` 得 0.5 分，以 `query` 开头额外得 0.3 分，区分大小写地包含 `query` 额外得 0.1 分，
+// 剩余 0.1 分按 `query` 占 `candidate` 的长度比例计算；不包含 `query` 或 `query` 为空时返回 0。
+//
+// 示例：
+// RelevanceScore("Apple", "apple") -> 0.9
+// RelevanceScore("apple", "apple") -> 1
+func RelevanceScore(candidate, query string) float64 {
+	if query == "" || candidate == "" {
+		return 0
+	}
+	lowerCandidate, lowerQuery := strings.ToLower(candidate), strings.ToLower(query)
+	pos := strings.Index(lowerCandidate, lowerQuery)
+	if pos < 0 {
+		return 0
+	}
+	score := 0.5
+	if pos == 0 {
+		score += 0.3
+	}
+	if strings.Contains(candidate, query) {
+		score += 0.1
+	}
+	score += 0.1 * float64(utf8.RuneCountInString(query)) / float64(utf8.RuneCountInString(candidate))
+	if score > 1 {
+		score = 1
+	}
+	return score
+}
+
+// RankByRelevance 按 RelevanceScore 计算的相关度降序返回 `candidates` 的副本，相关度相同时保持原有顺序。
+// `query` 为空时原样返回 `candidates`。
+func RankByRelevance(candidates []string, query string) []string {
+	if query == "" {
+		return candidates
+	}
+	var (
+		ranked = make([]string, len(candidates))
+		scores = make(map[string]float64, len(candidates))
+	)
+	copy(ranked, candidates)
+	for _, candidate := range candidates {
+		if _, ok := scores[candidate]; !ok {
+			scores[candidate] = RelevanceScore(candidate, query)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+	return ranked
+}
@@ -3,6 +3,7 @@ package gstr
 import (
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/utils"
+	"sort"
 	"strings"
 )
 
@@ -11,6 +12,25 @@ func Split(str, delimiter string) []string {
 	return strings.Split(str, delimiter)
 }
 
+// SplitLimit 将字符串 str 按 “delimiter” 分割成数组，并通过 limit 限制返回的元素数量。
+// 如果 limit 为正数，最多返回 limit 个元素，最后一个元素包含剩余未分割的部分；
+// 如果 limit 为 0 或 1，返回只包含整个字符串的数组；
+// 如果 limit 为负数，返回除最后 -limit 个元素之外的所有元素。
+// 请参阅 http://php.net/manual/en/function.explode.php。
+func SplitLimit(str, delimiter string, limit int) []string {
+	switch {
+	case limit == 0 || limit == 1:
+		return []string{str}
+	case limit > 1:
+		return strings.SplitN(str, delimiter, limit)
+	}
+	array := strings.Split(str, delimiter)
+	if -limit >= len(array) {
+		return []string{}
+	}
+	return array[:len(array)+limit]
+}
+
 // SplitAndTrim 将字符串 str 分割成字符串 “delimiter”，生成数组，
 // 并对数组中的每个元素调用 Trim 函数。
 // 它会忽略在 Trim 后为空的元素。
@@ -30,6 +50,32 @@ func JoinAny(array interface{}, sep string) string {
 	return strings.Join(gconv.Strings(array), sep)
 }
 
+// JoinMap 将映射 m 中的每个键值对使用 kvSep 连接，再将所有键值对使用 pairSep 连接，如 "a=1&b=2"。
+// 可选参数 sortKeys 为 true 时按键升序输出，保证结果确定，适用于生成签名；否则输出顺序不确定。
+// 空映射返回空字符串。
+func JoinMap(m map[string]string, kvSep, pairSep string, sortKeys ...bool) string {
+	if len(m) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(sortKeys) > 0 && sortKeys[0] {
+		sort.Strings(keys)
+	}
+	var buffer strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			buffer.WriteString(pairSep)
+		}
+		buffer.WriteString(k)
+		buffer.WriteString(kvSep)
+		buffer.WriteString(m[k])
+	}
+	return buffer.String()
+}
+
 // Explode 将字符串 str 分割成字符串 “delimiter”，生成数组。
 // 它与 Split 函数相同。
 // 请参阅 http://php.net/manual/en/function.explode.php。
@@ -1,6 +1,9 @@
 package gstr
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // Str 返回 `haystack` 字符串中从第一个出现的 `needle` 到 `haystack` 结尾的部分。
 //
@@ -179,6 +182,38 @@ func StrLimitRune(str string, length int, suffix ...string) string {
 	return string(runes[0:length]) + suffixStr
 }
 
+// EllipsisMiddle 在 `str` 的字符数超过 `maxRunes` 时保留其开头和结尾，并在中间插入省略符 `ellipsis`（默认为 "..."），
+// 使结果（包括省略符）的字符数等于 `maxRunes`，适用于显示过长的文件路径或 ID。
+// 开头和结尾保留的字符数尽量相等，无法平分时结尾多保留一个字符。
+// 如果 `str` 的字符数不超过 `maxRunes`，则原样返回；如果 `maxRunes` 小于省略符的字符数，则直接截取开头的 `maxRunes` 个字符。
+// EllipsisMiddle 考虑参数 `str` 为 Unicode 字符串。
+//
+// 示例：
+// EllipsisMiddle("/very/long/path/to/file.txt", 16) -> "/very/...ile.txt"
+// EllipsisMiddle("一二三四五六七八", 5, "~")          -> "一二~七八"
+func EllipsisMiddle(str string, maxRunes int, ellipsis ...string) string {
+	runes := []rune(str)
+	if len(runes) <= maxRunes {
+		return str
+	}
+	if maxRunes <= 0 {
+		return ""
+	}
+	ellipsisStr := defaultSuffixForStrLimit
+	if len(ellipsis) > 0 {
+		ellipsisStr = ellipsis[0]
+	}
+	keep := maxRunes - utf8.RuneCountInString(ellipsisStr)
+	if keep < 0 {
+		return string(runes[:maxRunes])
+	}
+	var (
+		head = keep / 2
+		tail = keep - head
+	)
+	return string(runes[:head]) + ellipsisStr + string(runes[len(runes)-tail:])
+}
+
 // SubStrFrom 返回 `str` 字符串中从第一个出现的 `need` 包括 `need` 到 `str` 结尾的部分。
 //
 // 示例：
@@ -111,3 +111,31 @@ func HasPrefix(s, prefix string) bool {
 func HasSuffix(s, suffix string) bool {
 	return strings.HasSuffix(s, suffix)
 }
+
+// CollapseWhitespace 将字符串 `s` 中连续的 Unicode 空白字符（包括空格、制表符、换行符等）替换为单个空格，
+// 并删除首尾空白。
+//
+// 示例：
+// CollapseWhitespace("  a \t b\n\nc ") -> "a b c"
+func CollapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// CollapseWhitespaceKeepNewlines 与 CollapseWhitespace 类似，但保留换行：
+// 每一行内连续的水平空白替换为单个空格并删除行首尾空白，连续的换行（包括空白行）合并为单个 `\n`，
+// `\r\n` 视为换行，结果首尾不包含空白。
+//
+// 示例：
+// CollapseWhitespaceKeepNewlines(" a \t b \n\n\n c ") -> "a b\nc"
+func CollapseWhitespaceKeepNewlines(s string) string {
+	var (
+		lines  = strings.Split(s, "\n")
+		result = make([]string, 0, len(lines))
+	)
+	for _, line := range lines {
+		if line = CollapseWhitespace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return strings.Join(result, "\n")
+}
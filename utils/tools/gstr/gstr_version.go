@@ -46,18 +46,20 @@ func IsGNUVersion(version string) bool {
 // v1.0.1
 // v2.10.8
 // 10.2.0
+// 1.0.0-rc.1
 // etc.
+//
+// 段数不同时缺少的段按 0 处理，即 "1.2" 与 "1.2.0" 相等。
+// 版本号可以带有以 `-` 分隔的预发布标识，预发布版本小于对应的正式版本，如 "1.0.0-rc" < "1.0.0"，
+// 两个预发布标识按语义化版本规则逐段比较，如 "1.0.0-alpha" < "1.0.0-alpha.1" < "1.0.0-beta" < "1.0.0-rc.1"。
+// `+` 之后的构建元数据不参与比较。
 func CompareVersion(a, b string) int {
-	if a != "" && a[0] == 'v' {
-		a = a[1:]
-	}
-	if b != "" && b[0] == 'v' {
-		b = b[1:]
-	}
 	var (
-		array1 = strings.Split(a, ".")
-		array2 = strings.Split(b, ".")
-		diff   int
+		coreA, preA = splitVersionPreRelease(a)
+		coreB, preB = splitVersionPreRelease(b)
+		array1      = strings.Split(coreA, ".")
+		array2      = strings.Split(coreB, ".")
+		diff        int
 	)
 	diff = len(array2) - len(array1)
 	for i := 0; i < diff; i++ {
@@ -79,6 +81,81 @@ func CompareVersion(a, b string) int {
 			return -1
 		}
 	}
+	return comparePreRelease(preA, preB)
+}
+
+// VersionLess 检查版本 `a` 是否小于版本 `b`，比较规则同 CompareVersion。
+func VersionLess(a, b string) bool {
+	return CompareVersion(a, b) < 0
+}
+
+// VersionGreater 检查版本 `a` 是否大于版本 `b`，比较规则同 CompareVersion。
+func VersionGreater(a, b string) bool {
+	return CompareVersion(a, b) > 0
+}
+
+// splitVersionPreRelease 去掉版本 `version` 的 `v` 前缀和构建元数据，并将其拆分为数字部分和预发布标识。
+func splitVersionPreRelease(version string) (core, preRelease string) {
+	version = strings.TrimSpace(version)
+	if version != "" && (version[0] == 'v' || version[0] == 'V') {
+		version = version[1:]
+	}
+	if i := strings.Index(version, "+"); i >= 0 {
+		version = version[:i]
+	}
+	if i := strings.Index(version, "-"); i >= 0 {
+		return version[:i], version[i+1:]
+	}
+	return version, ""
+}
+
+// comparePreRelease 按语义化版本规则比较预发布标识 `a` 和 `b`。
+// 没有预发布标识的版本大于有预发布标识的版本；纯数字段按数值比较并小于非数字段；
+// 非数字段按字典序比较；前面的段都相等时段数多的较大。
+func comparePreRelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	var (
+		array1 = strings.Split(a, ".")
+		array2 = strings.Split(b, ".")
+	)
+	for i := 0; i < len(array1) && i < len(array2); i++ {
+		var (
+			s1, s2     = array1[i], array2[i]
+			n1IsNumber = isVersionNumber(s1)
+			n2IsNumber = isVersionNumber(s2)
+		)
+		switch {
+		case n1IsNumber && n2IsNumber:
+			if v1, v2 := gconv.Int(s1), gconv.Int(s2); v1 != v2 {
+				if v1 > v2 {
+					return 1
+				}
+				return -1
+			}
+		case n1IsNumber:
+			return -1
+		case n2IsNumber:
+			return 1
+		case s1 != s2:
+			if s1 > s2 {
+				return 1
+			}
+			return -1
+		}
+	}
+	switch {
+	case len(array1) > len(array2):
+		return 1
+	case len(array1) < len(array2):
+		return -1
+	}
 	return 0
 }
 
@@ -180,3 +257,8 @@ func CompareVersionGo(a, b string) int {
 
 	return 0
 }
+
+// isVersionNumber 检查版本段 `s` 是否为不带符号的纯数字。
+func isVersionNumber(s string) bool {
+	return s != "" && s[0] != '-' && s[0] != '+' && IsNumeric(s)
+}
@@ -0,0 +1,34 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestRemoveAccents(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"café", "cafe"},
+		{"Niño señor", "Nino senor"},
+		{"Müller über", "Muller uber"},
+		{"Français garçon", "Francais garcon"},
+		{"ÀÉÎÕÜ", "AEIOU"},
+		{"Straße Æsir œuvre", "Strasse AEsir oeuvre"},
+		// Decomposed combining marks are removed.
+		{"café ñ", "cafe n"},
+		{"Hello, World! 123", "Hello, World! 123"},
+		{"你好世界", "你好世界"},
+		{"Привет мир", "Привет мир"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.RemoveAccents(tt.s); got != tt.want {
+			t.Errorf("RemoveAccents(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+	if got := gstr.SlugifyASCII(gstr.RemoveAccents("Café Crème")); got != "cafe-creme" {
+		t.Errorf("SlugifyASCII(RemoveAccents) = %q, want %q", got, "cafe-creme")
+	}
+}
@@ -0,0 +1,52 @@
+package gstr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestBase64(t *testing.T) {
+	tests := []struct {
+		s        string
+		std, url string
+	}{
+		{"hello world", "aGVsbG8gd29ybGQ=", "aGVsbG8gd29ybGQ"},
+		{"你好，世界", "5L2g5aW977yM5LiW55WM", "5L2g5aW977yM5LiW55WM"},
+		{"\xfb\xff\xfe", "+//+", "-__-"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.Base64Encode(tt.s); got != tt.std {
+			t.Errorf("Base64Encode(%q) = %q, want %q", tt.s, got, tt.std)
+		}
+		if got, err := gstr.Base64Decode(tt.std); err != nil || got != tt.s {
+			t.Errorf("Base64Decode(%q) = %q, %v, want %q", tt.std, got, err, tt.s)
+		}
+		if got := gstr.Base64EncodeURL(tt.s); got != tt.url {
+			t.Errorf("Base64EncodeURL(%q) = %q, want %q", tt.s, got, tt.url)
+		}
+		if got, err := gstr.Base64DecodeURL(tt.url); err != nil || got != tt.s {
+			t.Errorf("Base64DecodeURL(%q) = %q, %v, want %q", tt.url, got, err, tt.s)
+		}
+		// Padded input is accepted by the URL-safe decoder too.
+		padded := tt.url + strings.Repeat("=", (4-len(tt.url)%4)%4)
+		if got, err := gstr.Base64DecodeURL(padded); err != nil || got != tt.s {
+			t.Errorf("Base64DecodeURL(%q) = %q, %v, want %q", padded, got, err, tt.s)
+		}
+	}
+}
+
+func TestBase64_Invalid(t *testing.T) {
+	for _, s := range []string{"!!!!", "aGVsbG8=x", "-__-"} {
+		if _, err := gstr.Base64Decode(s); err == nil {
+			t.Errorf("Base64Decode(%q) should fail", s)
+		}
+	}
+	for _, s := range []string{"!!!!", "+//+", "a"} {
+		if _, err := gstr.Base64DecodeURL(s); err == nil {
+			t.Errorf("Base64DecodeURL(%q) should fail", s)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes      int64
+		decimals   []int
+		si, binary string
+	}{
+		{0, nil, "0 B", "0 B"},
+		{999, nil, "999 B", "999 B"},
+		{1500, nil, "1.5 KB", "1.46 KiB"},
+		{1536, nil, "1.54 KB", "1.5 KiB"},
+		{1500000, nil, "1.5 MB", "1.43 MiB"},
+		{2000000000, nil, "2 GB", "1.86 GiB"},
+		{1 << 30, nil, "1.07 GB", "1 GiB"},
+		{1234567, []int{0}, "1 MB", "1 MiB"},
+		{1234567, []int{3}, "1.235 MB", "1.177 MiB"},
+		{-1500, nil, "-1.5 KB", "-1.46 KiB"},
+	}
+	for _, tt := range tests {
+		if got := gstr.FormatBytes(tt.bytes, tt.decimals...); got != tt.si {
+			t.Errorf("FormatBytes(%d, %v) = %q, want %q", tt.bytes, tt.decimals, got, tt.si)
+		}
+		if got := gstr.FormatBytesBinary(tt.bytes, tt.decimals...); got != tt.binary {
+			t.Errorf("FormatBytesBinary(%d, %v) = %q, want %q", tt.bytes, tt.decimals, got, tt.binary)
+		}
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int64
+	}{
+		{"512", 512},
+		{"512B", 512},
+		{"1KB", 1000},
+		{"1KiB", 1024},
+		{"1.5MB", 1500000},
+		{"1.5 MiB", 1572864},
+		{"2 GiB", 2 << 30},
+		{"2gb", 2000000000},
+		{" 10 k ", 10000},
+		{"1Ti", 1 << 40},
+		{"-1KB", -1000},
+	}
+	for _, tt := range tests {
+		if got, err := gstr.ParseBytes(tt.s); err != nil || got != tt.want {
+			t.Errorf("ParseBytes(%q) = %d, %v, want %d", tt.s, got, err, tt.want)
+		}
+	}
+
+	for _, s := range []string{"", "abc", "MB", "1.5XB", "1..5MB", "8EiB", "10EB"} {
+		if _, err := gstr.ParseBytes(s); err == nil {
+			t.Errorf("ParseBytes(%q) should fail", s)
+		}
+	}
+}
+
+func TestFormatBytes_RoundTrip(t *testing.T) {
+	for _, bytes := range []int64{0, 1000, 1500000, 2000000000, 3000000000000} {
+		if got, err := gstr.ParseBytes(gstr.FormatBytes(bytes)); err != nil || got != bytes {
+			t.Errorf("ParseBytes(FormatBytes(%d)) = %d, %v", bytes, got, err)
+		}
+	}
+	for _, bytes := range []int64{1024, 1536, 1 << 20, 5 << 30} {
+		if got, err := gstr.ParseBytes(gstr.FormatBytesBinary(bytes)); err != nil || got != bytes {
+			t.Errorf("ParseBytes(FormatBytesBinary(%d)) = %d, %v", bytes, got, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestDetectCase(t *testing.T) {
+	tests := []struct {
+		s    string
+		want gstr.CaseType
+	}{
+		{"any_kind_of_string", gstr.Snake},
+		{"ANY_KIND_OF_STRING", gstr.SnakeScreaming},
+		{"any-kind-of-string", gstr.Kebab},
+		{"ANY-KIND-OF-STRING", gstr.KebabScreaming},
+		{"AnyKindOfString", gstr.Camel},
+		{"anyKindOfString", gstr.CamelLower},
+		{"userID2", gstr.CamelLower},
+		{"STRING", gstr.SnakeScreaming},
+		{"string", gstr.Lower},
+		{"string2", gstr.Lower},
+		{"", gstr.Lower},
+		{"mixed_kind-of", gstr.Lower},
+	}
+	for _, tt := range tests {
+		if got := gstr.DetectCase(tt.s); got != tt.want {
+			t.Errorf("DetectCase(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestDetectCase_RoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"any_kind_of_string",
+		"ANY_KIND_OF_STRING",
+		"any-kind-of-string",
+		"ANY-KIND-OF-STRING",
+		"AnyKindOfString",
+		"anyKindOfString",
+		"string",
+	} {
+		if got := gstr.CaseConvert(s, gstr.DetectCase(s)); got != s {
+			t.Errorf("CaseConvert(%q, DetectCase) = %q, want it unchanged", s, got)
+		}
+	}
+}
+
+func TestTitle(t *testing.T) {
+	tests := []struct {
+		s     string
+		title string
+		human string
+	}{
+		{"user_first_name", "User First Name", "User first name"},
+		{"user-first-name", "User First Name", "User first name"},
+		{"userFirstName", "User First Name", "User first name"},
+		{"UserFirstName", "User First Name", "User first name"},
+		{"userProfileURL", "User Profile URL", "User profile URL"},
+		{"api-user-id", "API User ID", "API user ID"},
+		{"html_to_json", "HTML To JSON", "HTML to JSON"},
+		{"name", "Name", "Name"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.Title(tt.s); got != tt.title {
+			t.Errorf("Title(%q) = %q, want %q", tt.s, got, tt.title)
+		}
+		if got := gstr.HumanReadable(tt.s); got != tt.human {
+			t.Errorf("HumanReadable(%q) = %q, want %q", tt.s, got, tt.human)
+		}
+	}
+}
+
+func TestCaseCamelAcronyms(t *testing.T) {
+	tests := []struct {
+		s        string
+		acronyms []string
+		want     string
+	}{
+		{"user_id", nil, "UserID"},
+		{"api_url", nil, "APIURL"},
+		{"http-json-uuid", nil, "HTTPJSONUUID"},
+		{"userIdentity", nil, "UserIdentity"},
+		{"user_profile_url", nil, "UserProfileURL"},
+		{"sku_code", []string{"sku"}, "SKUCode"},
+		{"order_sku_id", []string{"SKU"}, "OrderSKUID"},
+		{"sku_code", nil, "SkuCode"},
+		{"", nil, ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.CaseCamelAcronyms(tt.s, tt.acronyms...); got != tt.want {
+			t.Errorf("CaseCamelAcronyms(%q, %v) = %q, want %q", tt.s, tt.acronyms, got, tt.want)
+		}
+	}
+}
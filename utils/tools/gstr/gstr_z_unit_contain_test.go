@@ -0,0 +1,61 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestContainsAnyOfAllOf(t *testing.T) {
+	tests := []struct {
+		s       string
+		needles []string
+		any     bool
+		all     bool
+		anyI    bool
+		allI    bool
+	}{
+		{"hello world", []string{"world", "foo"}, true, false, true, false},
+		{"hello world", []string{"hello", "world"}, true, true, true, true},
+		{"hello world", []string{"foo", "bar"}, false, false, false, false},
+		{"Hello World", []string{"hello", "WORLD"}, false, false, true, true},
+		{"Hello World", []string{"hello", "x"}, false, false, true, false},
+		{"hello", nil, false, true, false, true},
+		{"hello", []string{}, false, true, false, true},
+		// The empty string is contained in any string, including the empty string.
+		{"abc", []string{""}, true, true, true, true},
+		{"", []string{""}, true, true, true, true},
+		{"", []string{"a"}, false, false, false, false},
+	}
+	for _, tt := range tests {
+		if got := gstr.ContainsAnyOf(tt.s, tt.needles); got != tt.any {
+			t.Errorf("ContainsAnyOf(%q, %q) = %v, want %v", tt.s, tt.needles, got, tt.any)
+		}
+		if got := gstr.ContainsAllOf(tt.s, tt.needles); got != tt.all {
+			t.Errorf("ContainsAllOf(%q, %q) = %v, want %v", tt.s, tt.needles, got, tt.all)
+		}
+		if got := gstr.ContainsAnyOfI(tt.s, tt.needles); got != tt.anyI {
+			t.Errorf("ContainsAnyOfI(%q, %q) = %v, want %v", tt.s, tt.needles, got, tt.anyI)
+		}
+		if got := gstr.ContainsAllOfI(tt.s, tt.needles); got != tt.allI {
+			t.Errorf("ContainsAllOfI(%q, %q) = %v, want %v", tt.s, tt.needles, got, tt.allI)
+		}
+	}
+}
+
+func TestContainsAny(t *testing.T) {
+	tests := []struct {
+		s, chars string
+		want     bool
+	}{
+		{"hello", "xyz", false},
+		{"hello", "xyo", true},
+		{"你好", "好", true},
+		{"hello", "", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.ContainsAny(tt.s, tt.chars); got != tt.want {
+			t.Errorf("ContainsAny(%q, %q) = %v, want %v", tt.s, tt.chars, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package gstr_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		s     string
+		slug  string
+		ascii string
+	}{
+		{"Hello, World!", "hello-world", "hello-world"},
+		{"  --Go: the  Good Parts--  ", "go-the-good-parts", "go-the-good-parts"},
+		{"Café Crème 2024", "café-crème-2024", "caf-cr-me-2024"},
+		{"你好 世界", "你好-世界", ""},
+		{"!!!", "", ""},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.Slugify(tt.s); got != tt.slug {
+			t.Errorf("Slugify(%q) = %q, want %q", tt.s, got, tt.slug)
+		}
+		if got := gstr.SlugifyASCII(tt.s); got != tt.ascii {
+			t.Errorf("SlugifyASCII(%q) = %q, want %q", tt.s, got, tt.ascii)
+		}
+	}
+	if got := gstr.Slugify("Hello, World!", "_"); got != "hello_world" {
+		t.Errorf("Slugify with separator = %q, want hello_world", got)
+	}
+}
+
+func TestKeepAlphaNumeric(t *testing.T) {
+	tests := []struct {
+		s     string
+		alnum string
+		clean string
+	}{
+		{"Hello, World! 123", "HelloWorld123", "Hello World 123"},
+		{"a+b=c", "abc", "abc"},
+		{"价格：100元！", "价格100元", "价格100元"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.KeepAlphaNumeric(tt.s); got != tt.alnum {
+			t.Errorf("KeepAlphaNumeric(%q) = %q, want %q", tt.s, got, tt.alnum)
+		}
+		if got := gstr.RemoveSymbols(tt.s); got != tt.clean {
+			t.Errorf("RemoveSymbols(%q) = %q, want %q", tt.s, got, tt.clean)
+		}
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		decimals []int
+		want     string
+	}{
+		{1234.56, "USD", nil, "$1,234.56"},
+		{1234567.891, "usd", nil, "$1,234,567.89"},
+		{-1234.5, "USD", nil, "-$1,234.50"},
+		{1234.56, "EUR", nil, "1.234,56 €"},
+		{-1234567.5, "EUR", nil, "-1.234.567,50 €"},
+		{1234.56, "JPY", nil, "¥1,235"},
+		{1234.56, "USD", []int{0}, "$1,235"},
+		{1234.56, "XYZ", nil, "XYZ 1,234.56"},
+		{0, "XYZ", []int{3}, "XYZ 0.000"},
+	}
+	for _, tt := range tests {
+		if got := gstr.FormatMoney(tt.amount, tt.currency, tt.decimals...); got != tt.want {
+			t.Errorf("FormatMoney(%v, %q, %v) = %q, want %q", tt.amount, tt.currency, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestWrapLines(t *testing.T) {
+	tests := []struct {
+		str   string
+		width int
+		want  []string
+	}{
+		{
+			"The quick brown fox jumps over the lazy dog",
+			10,
+			[]string{"The quick", "brown fox", "jumps over", "the lazy", "dog"},
+		},
+		{"hello world foo", 11, []string{"hello world", "foo"}},
+		{"a supercalifragilistic word", 8, []string{"a", "supercal", "ifragili", "stic", "word"}},
+		{"abcdefgh", 4, []string{"abcd", "efgh"}},
+		{"first line\nsecond line here", 11, []string{"first line", "second line", "here"}},
+		{"a\r\n\r\nb", 5, []string{"a", "", "b"}},
+		{"你好 世界 你好世界你好", 4, []string{"你好", "世界", "你好世界", "你好"}},
+		{"  many   spaces  ", 20, []string{"many spaces"}},
+		{"a b\nc", 0, []string{"a b", "c"}},
+		{"", 10, []string{""}},
+	}
+	for _, tt := range tests {
+		got := gstr.WrapLines(tt.str, tt.width)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("WrapLines(%q, %d) = %q, want %q", tt.str, tt.width, got, tt.want)
+		}
+	}
+}
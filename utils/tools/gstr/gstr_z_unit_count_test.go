@@ -0,0 +1,51 @@
+package gstr_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestTopWords(t *testing.T) {
+	paragraph := "go is fun and go is fast\nrust is fast too and go is simple"
+	want := []gstr.WordCount{
+		{Word: "is", Count: 4},
+		{Word: "go", Count: 3},
+		{Word: "and", Count: 2},
+	}
+	if got := gstr.TopWords(paragraph, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("TopWords(3) = %v, want %v", got, want)
+	}
+}
+
+func TestTopWords_TieBreak(t *testing.T) {
+	// Words with the same count are ordered alphabetically.
+	got := gstr.TopWords("pear apple fig apple pear fig banana", 0)
+	want := []gstr.WordCount{
+		{Word: "apple", Count: 2},
+		{Word: "fig", Count: 2},
+		{Word: "pear", Count: 2},
+		{Word: "banana", Count: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TopWords = %v, want %v", got, want)
+	}
+}
+
+func TestTopWords_All(t *testing.T) {
+	str := "a b b c c c"
+	want := []gstr.WordCount{
+		{Word: "c", Count: 3},
+		{Word: "b", Count: 2},
+		{Word: "a", Count: 1},
+	}
+	for _, n := range []int{0, -1, 10} {
+		if got := gstr.TopWords(str, n); !reflect.DeepEqual(got, want) {
+			t.Errorf("TopWords(%d) = %v, want %v", n, got, want)
+		}
+	}
+	if got := gstr.TopWords("", 3); len(got) != 0 {
+		t.Errorf(`TopWords("") = %v, want empty`, got)
+	}
+}
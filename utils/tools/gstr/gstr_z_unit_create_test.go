@@ -0,0 +1,31 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		count  int
+		repeat string
+		join   string
+	}{
+		{-1, "", ""},
+		{0, "", ""},
+		{1, "ab", "ab"},
+		{3, "ababab", "ab, ab, ab"},
+	}
+	for _, tt := range tests {
+		if got := gstr.Repeat("ab", tt.count); got != tt.repeat {
+			t.Errorf("Repeat(ab, %d) = %q, want %q", tt.count, got, tt.repeat)
+		}
+		if got := gstr.RepeatJoin("ab", ", ", tt.count); got != tt.join {
+			t.Errorf("RepeatJoin(ab, %d) = %q, want %q", tt.count, got, tt.join)
+		}
+	}
+	if got := gstr.RepeatJoin("?", ",", 3); got != "?,?,?" {
+		t.Errorf("RepeatJoin placeholders = %q, want ?,?,?", got)
+	}
+}
@@ -0,0 +1,103 @@
+package gstr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		s, prefix string
+		want      string
+		wantBlank string
+	}{
+		{"a\nb\nc", "  ", "  a\n  b\n  c", "  a\n  b\n  c"},
+		{"a\nb\n", "  ", "  a\n  b\n", "  a\n  b\n"},
+		{"a\n\n  \nb", "> ", "> a\n\n  \n> b", "> a\n> \n>   \n> b"},
+		{"a\r\nb\r\n", "\t", "\ta\r\n\tb\r\n", "\ta\r\n\tb\r\n"},
+		{"a\r\n\r\nb", "-", "-a\r\n\r\n-b", "-a\r\n-\r\n-b"},
+		{"", "  ", "", ""},
+		{"a\nb", "", "a\nb", "a\nb"},
+	}
+	for _, tt := range tests {
+		if got := gstr.Indent(tt.s, tt.prefix); got != tt.want {
+			t.Errorf("Indent(%q, %q) = %q, want %q", tt.s, tt.prefix, got, tt.want)
+		}
+		if got := gstr.Indent(tt.s, tt.prefix, true); got != tt.wantBlank {
+			t.Errorf("Indent(%q, %q, true) = %q, want %q", tt.s, tt.prefix, got, tt.wantBlank)
+		}
+	}
+}
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"    a\n      b\n    c", "a\n  b\nc"},
+		{"    a\n      b\n", "a\n  b\n"},
+		{"\n    a\n\n      b\n  \n", "\na\n\n  b\n\n"},
+		{"\t\ta\r\n\t\t\tb\r\n", "a\r\n\tb\r\n"},
+		{"  a\r\n \t \r\n  b", "a\r\n\r\nb"},
+		{"  a\n\tb", "  a\n\tb"},
+		{"a\n  b", "a\n  b"},
+		{"   \n  ", "\n"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.Dedent(tt.s); got != tt.want {
+			t.Errorf("Dedent(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+
+	// Dedent reverses Indent.
+	block := "func main() {\n\tprintln()\n}\n"
+	if got := gstr.Dedent(gstr.Indent(block, "    ")); got != block {
+		t.Errorf("Dedent(Indent(%q)) = %q", block, got)
+	}
+}
+
+func TestNumberLines(t *testing.T) {
+	tests := []struct {
+		text    string
+		startAt []int
+		want    string
+	}{
+		{"a\nb\nc", nil, "1 | a\n2 | b\n3 | c"},
+		// A trailing newline does not produce an extra numbered line.
+		{"a\nb\n", nil, "1 | a\n2 | b\n"},
+		{"a\r\nb\r\n", nil, "1 | a\r\n2 | b\r\n"},
+		{"a\n\nb", nil, "1 | a\n2 | \n3 | b"},
+		{"single", nil, "1 | single"},
+		{"", nil, ""},
+		// Custom start numbers.
+		{"a\nb", []int{5}, "5 | a\n6 | b"},
+		{"a\nb", []int{9}, " 9 | a\n10 | b"},
+		{"a", []int{0}, "0 | a"},
+		{"a\nb", []int{-1}, "-1 | a\n 0 | b"},
+	}
+	for _, tt := range tests {
+		if got := gstr.NumberLines(tt.text, tt.startAt...); got != tt.want {
+			t.Errorf("NumberLines(%q, %v) = %q, want %q", tt.text, tt.startAt, got, tt.want)
+		}
+	}
+}
+
+func TestNumberLines_Padding(t *testing.T) {
+	// Nine lines need no padding, ten lines pad the single-digit numbers.
+	nine := strings.Repeat("x\n", 9)
+	lines := strings.Split(gstr.NumberLines(nine), "\n")
+	if lines[0] != "1 | x" || lines[8] != "9 | x" {
+		t.Errorf("nine lines = %q", lines)
+	}
+
+	ten := strings.Repeat("x\n", 10)
+	lines = strings.Split(gstr.NumberLines(ten), "\n")
+	if lines[0] != " 1 | x" || lines[8] != " 9 | x" || lines[9] != "10 | x" {
+		t.Errorf("ten lines = %q", lines)
+	}
+	if lines[10] != "" || len(lines) != 11 {
+		t.Errorf("trailing newline should be kept without a number, got %q", lines)
+	}
+}
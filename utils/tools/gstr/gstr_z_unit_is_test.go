@@ -0,0 +1,111 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestIsEmail(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"john@example.com", true},
+		{"john.smith+tag@mail.example.co.uk", true},
+		{"user@localhost", true},
+		{"user_name-1@sub-domain.example.org", true},
+		{"", false},
+		{"john", false},
+		{"john@", false},
+		{"@example.com", false},
+		{"john@@example.com", false},
+		{"john@-example.com", false},
+		{"john@example-.com", false},
+		{"john@example..com", false},
+		{"john doe@example.com", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.IsEmail(tt.s); got != tt.want {
+			t.Errorf("IsEmail(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIsURL(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"https://example.com", true},
+		{"http://example.com:8080/path?q=1#top", true},
+		{"ftp://files.example.com", true},
+		{"http://localhost", true},
+		{"", false},
+		{"example.com", false},
+		{"www.example.com/path", false},
+		{"/relative/path", false},
+		{"https://", false},
+		{"mailto:john@example.com", false},
+		{"http://exa mple.com", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.IsURL(tt.s); got != tt.want {
+			t.Errorf("IsURL(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIsIP(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"127.0.0.1", true},
+		{"192.168.1.255", true},
+		{"::1", true},
+		{"2001:db8::ff00:42:8329", true},
+		{"", false},
+		{"256.0.0.1", false},
+		{"1.2.3", false},
+		{"1.2.3.4.5", false},
+		{"localhost", false},
+		{"192.168.1.1/24", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.IsIP(tt.s); got != tt.want {
+			t.Errorf("IsIP(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIsBlank(t *testing.T) {
+	tests := []struct {
+		s     string
+		blank bool
+	}{
+		{"", true},
+		{"   ", true},
+		{" \t\r\n ", true},
+		{"　 ", true},
+		{" a ", false},
+		{"\tvalue\n", false},
+		{"你好", false},
+		{"0", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.IsBlank(tt.s); got != tt.blank {
+			t.Errorf("IsBlank(%q) = %v, want %v", tt.s, got, tt.blank)
+		}
+		if got := gstr.IsNotBlank(tt.s); got != !tt.blank {
+			t.Errorf("IsNotBlank(%q) = %v, want %v", tt.s, got, !tt.blank)
+		}
+		want := tt.s
+		if tt.blank {
+			want = "default"
+		}
+		if got := gstr.DefaultIfBlank(tt.s, "default"); got != want {
+			t.Errorf("DefaultIfBlank(%q) = %q, want %q", tt.s, got, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestRuneLenByteLen(t *testing.T) {
+	tests := []struct {
+		s         string
+		runeLen   int
+		byteLen   int
+		multiByte bool
+	}{
+		{"hello", 5, 5, false},
+		{"你好世界", 4, 12, true},
+		{"go语言", 4, 8, true},
+		{"café", 4, 5, true},
+		{"😀", 1, 4, true},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := gstr.RuneLen(tt.s); got != tt.runeLen {
+			t.Errorf("RuneLen(%q) = %d, want %d", tt.s, got, tt.runeLen)
+		}
+		if got := gstr.ByteLen(tt.s); got != tt.byteLen {
+			t.Errorf("ByteLen(%q) = %d, want %d", tt.s, got, tt.byteLen)
+		}
+		if got := gstr.IsMultiByte(tt.s); got != tt.multiByte {
+			t.Errorf("IsMultiByte(%q) = %v, want %v", tt.s, got, tt.multiByte)
+		}
+	}
+}
+
+func TestRunePos(t *testing.T) {
+	tests := []struct {
+		s    string
+		r    rune
+		want int
+	}{
+		{"hello", 'l', 2},
+		{"你好世界", '世', 2},
+		{"go语言go", 'g', 0},
+		{"go语言go", '言', 3},
+		{"😀a", 'a', 1},
+		{"hello", 'z', -1},
+		{"", 'a', -1},
+	}
+	for _, tt := range tests {
+		if got := gstr.RunePos(tt.s, tt.r); got != tt.want {
+			t.Errorf("RunePos(%q, %q) = %d, want %d", tt.s, tt.r, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package gstr_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestParseKeyValue(t *testing.T) {
+	tests := []struct {
+		s, sep     string
+		key, value string
+		ok         bool
+	}{
+		{"key: value", ":", "key", "value", true},
+		{"  name = john  ", "=", "name", "john", true},
+		{"url=http://a.com/?x=1", "=", "url", "http://a.com/?x=1", true},
+		{"time: 12:30:00", ":", "time", "12:30:00", true},
+		{"empty=", "=", "empty", "", true},
+		{"a => b", "=>", "a", "b", true},
+		{"no separator", "=", "", "", false},
+		{" = value", "=", "", "", false},
+		{"key=value", "", "", "", false},
+	}
+	for _, tt := range tests {
+		key, value, ok := gstr.ParseKeyValue(tt.s, tt.sep)
+		if key != tt.key || value != tt.value || ok != tt.ok {
+			t.Errorf("ParseKeyValue(%q, %q) = %q, %q, %v, want %q, %q, %v",
+				tt.s, tt.sep, key, value, ok, tt.key, tt.value, tt.ok)
+		}
+	}
+}
+
+func TestParseKeyValueLines(t *testing.T) {
+	text := `
+# database config
+host: 127.0.0.1
+port: 3306
+
+  # indented comment
+dsn: root:pass@tcp(127.0.0.1:3306)/admin
+invalid line
+user:admin
+user: root
+`
+	want := map[string]string{
+		"host": "127.0.0.1",
+		"port": "3306",
+		"dsn":  "root:pass@tcp(127.0.0.1:3306)/admin",
+		"user": "root",
+	}
+	if got := gstr.ParseKeyValueLines(text, ":"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseKeyValueLines = %v, want %v", got, want)
+	}
+	if got := gstr.ParseKeyValueLines("a=1\r\nb=2\r\n", "="); !reflect.DeepEqual(got, map[string]string{"a": "1", "b": "2"}) {
+		t.Errorf("ParseKeyValueLines with CRLF = %v", got)
+	}
+	if got := gstr.ParseKeyValueLines("", "="); got == nil || len(got) != 0 {
+		t.Errorf("ParseKeyValueLines(empty) = %#v, want an empty map", got)
+	}
+}
@@ -0,0 +1,93 @@
+package gstr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		// *
+		{"user:*:profile", "user:42:profile", true},
+		{"user:*:profile", "user::profile", true},
+		{"user:*:profile", "user:1:2:profile", true},
+		{"user:*:profile", "user:42:settings", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"**", "abc", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"*.txt", "file.txt.bak", false},
+		// ?
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+		{"file?.txt", "file.txt", false},
+		{"??", "你好", true},
+		// Character classes.
+		{"[abc]1", "b1", true},
+		{"[abc]1", "d1", false},
+		{"v[0-9]", "v7", true},
+		{"v[0-9]", "vx", false},
+		{"[!abc]", "d", true},
+		{"[^abc]", "a", false},
+		{"[]a]", "]", true},
+		{"[a-c\\]]", "]", true},
+		{"[一-龥]", "中", true},
+		{"[abc", "[abc", true},
+		// Escaped literals.
+		{`a\*b`, "a*b", true},
+		{`a\*b`, "axb", false},
+		{`what\?`, "what?", true},
+		{`\[abc]`, "[abc]", true},
+		{`a\\b`, `a\b`, true},
+		// Anchoring at both ends.
+		{"abc", "abc", true},
+		{"abc", "xabc", false},
+		{"abc", "abcx", false},
+		{"b*", "abc", false},
+		{"*b", "abc", false},
+		{"", "", true},
+		{"", "a", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.Match(tt.pattern, tt.s); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestMatch_Linear(t *testing.T) {
+	// Patterns that cause exponential backtracking with a recursive matcher.
+	pattern := strings.Repeat("a*", 30) + "b"
+	s := strings.Repeat("a", 5000)
+	if gstr.Match(pattern, s) {
+		t.Errorf("Match(%q, a...) = true, want false", pattern)
+	}
+}
+
+func TestMatchAny(t *testing.T) {
+	patterns := []string{"user:*:profile", "admin:?"}
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"user:1:profile", true},
+		{"admin:1", true},
+		{"admin:10", false},
+		{"guest", false},
+	}
+	for _, tt := range tests {
+		if got := gstr.MatchAny(patterns, tt.s); got != tt.want {
+			t.Errorf("MatchAny(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+	if gstr.MatchAny(nil, "a") {
+		t.Error("MatchAny with no patterns should be false")
+	}
+}
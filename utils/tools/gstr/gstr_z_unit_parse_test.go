@@ -0,0 +1,61 @@
+package gstr_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestBuildQuery(t *testing.T) {
+	params := map[string]string{"b": "2", "a": "1", "c": "x y"}
+	want := "a=1&b=2&c=x+y"
+	// Keys are sorted so the output is deterministic.
+	for i := 0; i < 10; i++ {
+		if got := gstr.BuildQuery(params); got != want {
+			t.Fatalf("BuildQuery = %q, want %q", got, want)
+		}
+	}
+	if got := gstr.BuildQuery(nil); got != "" {
+		t.Errorf("BuildQuery(nil) = %q, want empty", got)
+	}
+	if got := gstr.BuildQuery(map[string]string{}); got != "" {
+		t.Errorf("BuildQuery({}) = %q, want empty", got)
+	}
+}
+
+func TestBuildQuery_RoundTrip(t *testing.T) {
+	params := map[string]string{
+		"q":        "a&b=c",
+		"name":     "hello world",
+		"城市":       "上海",
+		"k=v":      "1+1",
+		"empty":    "",
+		"percent%": "100%",
+	}
+	query := gstr.BuildQuery(params)
+	if got := gstr.ParseQuery(query); !reflect.DeepEqual(got, params) {
+		t.Errorf("ParseQuery(BuildQuery(params)) = %v, want %v", got, params)
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  map[string]string
+	}{
+		{"a=1&b=2", map[string]string{"a": "1", "b": "2"}},
+		{"?a=1", map[string]string{"a": "1"}},
+		// Only the first value is kept for repeated keys.
+		{"a=1&a=2", map[string]string{"a": "1"}},
+		{"name=hello+world&c=%E4%B8%8A", map[string]string{"name": "hello world", "c": "上"}},
+		// Pairs that fail to be decoded are skipped.
+		{"a=%zz&b=2", map[string]string{"b": "2"}},
+		{"", map[string]string{}},
+	}
+	for _, tt := range tests {
+		if got := gstr.ParseQuery(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseQuery(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package gstr_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestPosAll(t *testing.T) {
+	tests := []struct {
+		haystack, needle string
+		bytes, runes     []int
+	}{
+		{"a,b,c,d", ",", []int{1, 3, 5}, []int{1, 3, 5}},
+		{"go gopher go", "go", []int{0, 3, 10}, []int{0, 3, 10}},
+		{"aaaa", "aa", []int{0, 2}, []int{0, 2}},
+		{"abababa", "aba", []int{0, 4}, []int{0, 4}},
+		{"你好世界你好", "你好", []int{0, 12}, []int{0, 4}},
+		{"ab你好ab世界ab", "ab", []int{0, 8, 16}, []int{0, 4, 8}},
+		{"hello", "x", []int{}, []int{}},
+		{"hello", "", []int{}, []int{}},
+		{"", "a", []int{}, []int{}},
+	}
+	for _, tt := range tests {
+		if got := gstr.PosAll(tt.haystack, tt.needle); !reflect.DeepEqual(got, tt.bytes) {
+			t.Errorf("PosAll(%q, %q) = %v, want %v", tt.haystack, tt.needle, got, tt.bytes)
+		}
+		if got := gstr.PosAllRune(tt.haystack, tt.needle); !reflect.DeepEqual(got, tt.runes) {
+			t.Errorf("PosAllRune(%q, %q) = %v, want %v", tt.haystack, tt.needle, got, tt.runes)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestReplaceFirstLast(t *testing.T) {
+	tests := []struct {
+		origin, search, replace string
+		first, last, lastI      string
+	}{
+		{"a-b-c", "-", "+", "a+b-c", "a-b+c", "a-b+c"},
+		{"abc", "x", "y", "abc", "abc", "abc"},
+		{"abc", "", "y", "yabc", "abc", "abc"},
+		{"", "a", "y", "", "", ""},
+		{"Go go GO", "go", "_", "Go _ GO", "Go _ GO", "Go go _"},
+		{"foofoo", "foo", "bar", "barfoo", "foobar", "foobar"},
+		{"你好世界你好", "你好", "hi", "hi世界你好", "你好世界hi", "你好世界hi"},
+		{"İx İx", "İX", "_", "İx İx", "İx İx", "İx _"},
+		{"ǅa ǆA", "Ǆa", "_", "ǅa ǆA", "ǅa ǆA", "ǅa _"},
+		{"Kelvin \u212a", "k", "_", "Kelvin \u212a", "Kelvin \u212a", "Kelvin _"},
+	}
+	for _, tt := range tests {
+		if got := gstr.ReplaceFirst(tt.origin, tt.search, tt.replace); got != tt.first {
+			t.Errorf("ReplaceFirst(%q, %q, %q) = %q, want %q", tt.origin, tt.search, tt.replace, got, tt.first)
+		}
+		if got := gstr.ReplaceLast(tt.origin, tt.search, tt.replace); got != tt.last {
+			t.Errorf("ReplaceLast(%q, %q, %q) = %q, want %q", tt.origin, tt.search, tt.replace, got, tt.last)
+		}
+		if got := gstr.ReplaceLastI(tt.origin, tt.search, tt.replace); got != tt.lastI {
+			t.Errorf("ReplaceLastI(%q, %q, %q) = %q, want %q", tt.origin, tt.search, tt.replace, got, tt.lastI)
+		}
+	}
+}
+
+func TestReplaceWord(t *testing.T) {
+	tests := []struct {
+		origin, search, replace string
+		want, wantI             string
+	}{
+		{"the cat sat", "cat", "dog", "the dog sat", "the dog sat"},
+		{"category", "cat", "dog", "category", "category"},
+		{"the Cat sat in category", "cat", "dog", "the Cat sat in category", "the dog sat in category"},
+		{"cat,cat.cat", "cat", "dog", "dog,dog.dog", "dog,dog.dog"},
+		{"bobcat cat_1 cat2", "cat", "dog", "bobcat cat_1 cat2", "bobcat cat_1 cat2"},
+		{"猫cat 猫", "cat", "dog", "猫cat 猫", "猫cat 猫"},
+		{"你好 世界", "世界", "world", "你好 world", "你好 world"},
+		{"the cat sat", "", "dog", "the cat sat", "the cat sat"},
+		{"", "cat", "dog", "", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.ReplaceWord(tt.origin, tt.search, tt.replace); got != tt.want {
+			t.Errorf("ReplaceWord(%q, %q, %q) = %q, want %q", tt.origin, tt.search, tt.replace, got, tt.want)
+		}
+		if got := gstr.ReplaceWordI(tt.origin, tt.search, tt.replace); got != tt.wantI {
+			t.Errorf("ReplaceWordI(%q, %q, %q) = %q, want %q", tt.origin, tt.search, tt.replace, got, tt.wantI)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text  string
+		terms []string
+		want  string
+	}{
+		// Multiple terms.
+		{"go and rust", []string{"go", "rust"}, "<mark>go</mark> and <mark>rust</mark>"},
+		// Original casing is preserved inside the markup.
+		{"Go, GO and gO", []string{"go"}, "<mark>Go</mark>, <mark>GO</mark> and <mark>gO</mark>"},
+		// The longest term wins at the same position.
+		{"Go and Golang", []string{"go", "golang"}, "<mark>Go</mark> and <mark>Golang</mark>"},
+		// Overlapping terms are not wrapped twice.
+		{"abcd", []string{"abc", "bcd"}, "<mark>abc</mark>d"},
+		{"banana", []string{"ana"}, "b<mark>ana</mark>na"},
+		// Multi-byte text.
+		{"你好世界", []string{"世界"}, "你好<mark>世界</mark>"},
+		// Empty terms are ignored.
+		{"text", []string{""}, "text"},
+		{"text", nil, "text"},
+		{"", []string{"go"}, ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.Highlight(tt.text, tt.terms, "<mark>", "</mark>"); got != tt.want {
+			t.Errorf("Highlight(%q, %q) = %q, want %q", tt.text, tt.terms, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package gstr_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestRelevanceScore(t *testing.T) {
+	tests := []struct {
+		candidate string
+		query     string
+		want      float64
+	}{
+		{"apple", "apple", 1},
+		{"Apple", "apple", 0.9},
+		{"apple pie", "apple", 0.9 + 0.1*5/9},
+		{"pineapple", "apple", 0.6 + 0.1*5/9},
+		{"PINEAPPLE", "apple", 0.5 + 0.1*5/9},
+		{"你好世界", "世界", 0.6 + 0.1*2/4},
+		{"banana", "apple", 0},
+		{"apple", "", 0},
+		{"", "apple", 0},
+	}
+	for _, tt := range tests {
+		got := gstr.RelevanceScore(tt.candidate, tt.query)
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("RelevanceScore(%q, %q) = %v, want %v", tt.candidate, tt.query, got, tt.want)
+		}
+		if got < 0 || got > 1 {
+			t.Errorf("RelevanceScore(%q, %q) = %v, want within [0, 1]", tt.candidate, tt.query, got)
+		}
+	}
+}
+
+func TestRankByRelevance(t *testing.T) {
+	candidates := []string{"pineapple", "banana", "Apple pie", "apple"}
+	want := []string{"apple", "Apple pie", "pineapple", "banana"}
+	if got := gstr.RankByRelevance(candidates, "apple"); !reflect.DeepEqual(got, want) {
+		t.Errorf("RankByRelevance = %q, want %q", got, want)
+	}
+	// The input slice is not modified.
+	if candidates[0] != "pineapple" || candidates[3] != "apple" {
+		t.Errorf("candidates were modified: %q", candidates)
+	}
+}
+
+func TestRankByRelevance_PrefixFirst(t *testing.T) {
+	// A prefix match outranks a mid-string match even when it is longer.
+	candidates := []string{"my user", "user management console"}
+	want := []string{"user management console", "my user"}
+	if got := gstr.RankByRelevance(candidates, "user"); !reflect.DeepEqual(got, want) {
+		t.Errorf("RankByRelevance = %q, want %q", got, want)
+	}
+}
+
+func TestRankByRelevance_Stable(t *testing.T) {
+	// Candidates with the same score keep their input order.
+	candidates := []string{"cat", "car", "cab", "dog", "cow"}
+	want := []string{"cat", "car", "cab", "cow", "dog"}
+	if got := gstr.RankByRelevance(candidates, "c"); !reflect.DeepEqual(got, want) {
+		t.Errorf("RankByRelevance = %q, want %q", got, want)
+	}
+	duplicates := []string{"go", "Go", "go"}
+	if got := gstr.RankByRelevance(duplicates, "go"); !reflect.DeepEqual(got, []string{"go", "go", "Go"}) {
+		t.Errorf("RankByRelevance = %q, want [go go Go]", got)
+	}
+}
+
+func TestRankByRelevance_EmptyQuery(t *testing.T) {
+	candidates := []string{"b", "a", "c"}
+	if got := gstr.RankByRelevance(candidates, ""); !reflect.DeepEqual(got, candidates) {
+		t.Errorf("RankByRelevance = %q, want %q", got, candidates)
+	}
+	if got := gstr.RankByRelevance(nil, "a"); len(got) != 0 {
+		t.Errorf("RankByRelevance(nil) = %q, want empty", got)
+	}
+}
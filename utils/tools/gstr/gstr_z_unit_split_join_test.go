@@ -0,0 +1,62 @@
+package gstr_test
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestSplitLimit(t *testing.T) {
+	tests := []struct {
+		str   string
+		limit int
+		want  []string
+	}{
+		{"a,b,c,d", 2, []string{"a", "b,c,d"}},
+		{"a,b,c,d", 4, []string{"a", "b", "c", "d"}},
+		{"a,b,c,d", 10, []string{"a", "b", "c", "d"}},
+		{"a,b,c,d", 1, []string{"a,b,c,d"}},
+		{"a,b,c,d", 0, []string{"a,b,c,d"}},
+		{"a,b,c,d", -1, []string{"a", "b", "c"}},
+		{"a,b,c,d", -3, []string{"a"}},
+		{"a,b,c,d", -4, []string{}},
+		{"a,b,c,d", -10, []string{}},
+		{"abc", 2, []string{"abc"}},
+	}
+	for _, tt := range tests {
+		if got := gstr.SplitLimit(tt.str, ",", tt.limit); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitLimit(%q, %d) = %q, want %q", tt.str, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestJoinMap(t *testing.T) {
+	m := map[string]string{"timestamp": "1700000000", "appid": "wx01", "nonce": "abc", "b": ""}
+	want := "appid=wx01&b=&nonce=abc&timestamp=1700000000"
+	for i := 0; i < 10; i++ {
+		if got := gstr.JoinMap(m, "=", "&", true); got != want {
+			t.Fatalf("JoinMap sorted = %q, want %q", got, want)
+		}
+	}
+	if got := gstr.JoinMap(map[string]string{"b": "2", "a": "1"}, ": ", "\n", true); got != "a: 1\nb: 2" {
+		t.Errorf("JoinMap with custom separators = %q", got)
+	}
+
+	// Unsorted output contains all the pairs in any order.
+	got := gstr.JoinMap(m, "=", "&")
+	pairs := strings.Split(got, "&")
+	sort.Strings(pairs)
+	if strings.Join(pairs, "&") != want {
+		t.Errorf("JoinMap unsorted = %q, want the pairs of %q", got, want)
+	}
+
+	if got = gstr.JoinMap(nil, "=", "&", true); got != "" {
+		t.Errorf("JoinMap(nil) = %q, want empty", got)
+	}
+	if got = gstr.JoinMap(map[string]string{}, "=", "&"); got != "" {
+		t.Errorf("JoinMap(empty) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,42 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestEllipsisMiddle(t *testing.T) {
+	tests := []struct {
+		str      string
+		maxRunes int
+		ellipsis []string
+		want     string
+	}{
+		{"/very/long/path/to/file.txt", 16, nil, "/very/...ile.txt"},
+		// The tail keeps one more rune when the rest cannot be split evenly.
+		{"abcdefghij", 6, nil, "a...ij"},
+		{"一二三四五六七八", 5, []string{"~"}, "一二~七八"},
+		{"一二三四五六七八", 7, []string{"……"}, "一二……六七八"},
+		// Strings not longer than the limit are returned unchanged.
+		{"short", 10, nil, "short"},
+		{"short", 5, nil, "short"},
+		{"你好", 2, nil, "你好"},
+		{"", 3, nil, ""},
+		// A limit smaller than the ellipsis cuts the head.
+		{"abcdefghij", 2, nil, "ab"},
+		{"一二三四五", 2, nil, "一二"},
+		{"abcdefghij", 3, nil, "..."},
+		{"abcdefghij", 0, nil, ""},
+		{"abcdefghij", -1, nil, ""},
+	}
+	for _, tt := range tests {
+		got := gstr.EllipsisMiddle(tt.str, tt.maxRunes, tt.ellipsis...)
+		if got != tt.want {
+			t.Errorf("EllipsisMiddle(%q, %d, %q) = %q, want %q", tt.str, tt.maxRunes, tt.ellipsis, got, tt.want)
+		}
+		if len(tt.str) > 0 && gstr.RuneLen(tt.str) > tt.maxRunes && tt.maxRunes >= 0 && gstr.RuneLen(got) != tt.maxRunes {
+			t.Errorf("EllipsisMiddle(%q, %d) has %d runes, want %d", tt.str, tt.maxRunes, gstr.RuneLen(got), tt.maxRunes)
+		}
+	}
+}
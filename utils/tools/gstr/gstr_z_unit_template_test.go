@@ -0,0 +1,32 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestReplaceTemplate(t *testing.T) {
+	vars := map[string]string{"name": "john", "age": "18"}
+	tests := []struct {
+		tpl  string
+		want string
+	}{
+		{"Hello {name}", "Hello john"},
+		{"Hello ${name}", "Hello john"},
+		{"{name} is ${age}", "john is 18"},
+		{"Hello {unknown}", "Hello {unknown}"},
+		{"Hello ${unknown}", "Hello ${unknown}"},
+		{"{{name}}", "{name}"},
+		{"{{${name}}}", "{john}"},
+		{"cost: $5 {", "cost: $5 {"},
+		{"{name", "{name"},
+		{"{na{name}", "{najohn"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.ReplaceTemplate(tt.tpl, vars); got != tt.want {
+			t.Errorf("ReplaceTemplate(%q) = %q, want %q", tt.tpl, got, tt.want)
+		}
+	}
+}
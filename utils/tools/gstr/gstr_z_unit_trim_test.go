@@ -0,0 +1,32 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestCollapseWhitespace(t *testing.T) {
+	tests := []struct {
+		s            string
+		want         string
+		keepNewlines string
+	}{
+		{"  a \t b\n\nc ", "a b c", "a b\nc"},
+		{"hello\t\t world", "hello world", "hello world"},
+		{" a \t b \n\n\n c ", "a b c", "a b\nc"},
+		{"line1\r\n  line2\r\n", "line1 line2", "line1\nline2"},
+		{"a\n \t \nb", "a b", "a\nb"},
+		{"你好　 世界", "你好 世界", "你好 世界"},
+		{"\n\t  \n", "", ""},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := gstr.CollapseWhitespace(tt.s); got != tt.want {
+			t.Errorf("CollapseWhitespace(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+		if got := gstr.CollapseWhitespaceKeepNewlines(tt.s); got != tt.keepNewlines {
+			t.Errorf("CollapseWhitespaceKeepNewlines(%q) = %q, want %q", tt.s, got, tt.keepNewlines)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package gstr_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gstr"
+)
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2", "1.2.0", 0},
+		{"1.2.0.0", "1.2", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"V2.0", "v2", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.9", 1},
+		{"2.0", "1.99.99", 1},
+		{"1.2.1", "1.2", 1},
+		{"1.0.0-rc", "1.0.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+		{"1.0.1-rc", "1.0.0", 1},
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+		{"v1.0.0-rc+build", "1.0.0-rc", 0},
+	}
+	for _, tt := range tests {
+		if got := gstr.CompareVersion(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersion(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := gstr.CompareVersion(tt.b, tt.a); got != -tt.want {
+			t.Errorf("CompareVersion(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+		if got := gstr.VersionLess(tt.a, tt.b); got != (tt.want < 0) {
+			t.Errorf("VersionLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want < 0)
+		}
+		if got := gstr.VersionGreater(tt.a, tt.b); got != (tt.want > 0) {
+			t.Errorf("VersionGreater(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want > 0)
+		}
+	}
+}
@@ -25,17 +25,33 @@ import (
 
 // Timer is the timer manager, which uses ticks to calculate the timing interval.
 type Timer struct {
-	mu      sync.RWMutex
-	queue   *priorityQueue // queue is a priority queue based on heap structure.
-	status  *gtype.Int     // status is the current timer status.
-	ticks   *gtype.Int64   // ticks is the proceeded interval number by the timer.
-	options TimerOptions   // timer options is used for timer configuration.
+	mu       sync.RWMutex
+	queue    *priorityQueue    // queue is a priority queue based on heap structure.
+	status   *gtype.Int        // status is the current timer status.
+	ticks    *gtype.Int64      // ticks is the proceeded interval number by the timer.
+	options  TimerOptions      // timer options is used for timer configuration.
+	running  sync.WaitGroup    // running tracks the jobs that are currently executing.
+	fired    *gtype.Int64      // fired is the total count of job runs started by the timer.
+	panicked *gtype.Int64      // panicked is the total count of job runs that panicked.
+	namesMu  sync.Mutex        // namesMu protects names.
+	names    map[string]*Entry // names is the registry of named jobs added by AddUnique.
+}
+
+// TimerMetrics is the snapshot of the timer's scheduling statistics.
+type TimerMetrics struct {
+	QueueLength  int   // QueueLength is the count of jobs waiting in the timer queue.
+	TotalFired   int64 // TotalFired is the total count of job runs started by the timer.
+	PanickedJobs int64 // PanickedJobs is the total count of job runs that panicked, not including Exit.
+	Ticks        int64 // Ticks is the proceeded interval number by the timer.
 }
 
 // TimerOptions is the configuration object for Timer.
 type TimerOptions struct {
 	Interval time.Duration // (optional) Interval is the underlying rolling interval tick of the timer.
 	Quick    bool          // Quick is used for quick timer, which means the timer will not wait for the first interval to be elapsed.
+	// (optional) PanicHandler is called with the recovered error when a job panics.
+	// The panic is written to the standard logger if it is not set.
+	PanicHandler func(ctx context.Context, err error)
 }
 
 // internalPanic is the custom panic for internal usage.
@@ -93,6 +109,24 @@ func Add(ctx context.Context, interval time.Duration, job JobFunc) *Entry {
 	return defaultTimer.Add(ctx, interval, job)
 }
 
+// AddCancelable adds a timing job to the default timer, and returns the entry along with a cancel function
+// which closes the entry and cancels the context of the job. See Timer.AddCancelable.
+func AddCancelable(ctx context.Context, interval time.Duration, job JobFunc) (*Entry, context.CancelFunc) {
+	return defaultTimer.AddCancelable(ctx, interval, job)
+}
+
+// AddUnique adds a timing job named `name` to the default timer only if no job with the same name is registered.
+// See Timer.AddUnique.
+func AddUnique(ctx context.Context, name string, interval time.Duration, job JobFunc) (*Entry, bool) {
+	return defaultTimer.AddUnique(ctx, name, interval, job)
+}
+
+// AddWithJitter adds a timing job to the default timer, whose interval is randomized
+// by up to `maxJitter` for each run. Also see Timer.AddWithJitter.
+func AddWithJitter(ctx context.Context, interval, maxJitter time.Duration, job JobFunc) *Entry {
+	return defaultTimer.AddWithJitter(ctx, interval, maxJitter, job)
+}
+
 // AddEntry adds a timing job to the default timer with detailed parameters.
 //
 // The parameter `interval` specifies the running interval of the job.
@@ -152,3 +186,14 @@ func DelayAddOnce(ctx context.Context, delay time.Duration, interval time.Durati
 func DelayAddTimes(ctx context.Context, delay time.Duration, interval time.Duration, times int, job JobFunc) {
 	defaultTimer.DelayAddTimes(ctx, delay, interval, times, job)
 }
+
+// Close closes the default timer, no more jobs are scheduled after it returns.
+func Close() {
+	defaultTimer.Close()
+}
+
+// CloseGracefully closes the default timer and waits for its running jobs.
+// Also see Timer.CloseGracefully.
+func CloseGracefully(ctx context.Context) error {
+	return defaultTimer.CloseGracefully(ctx)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/grand"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtype"
+	"time"
 )
 
 // Entry is the timing job.
@@ -18,6 +20,8 @@ type Entry struct {
 	isSingleton *gtype.Bool     // Singleton mode.
 	nextTicks   *gtype.Int64    // Next run ticks of the job.
 	infinite    *gtype.Bool     // No times limit.
+	jitter      time.Duration   // Max random jitter applied to the interval of each run.
+	name        string          // Unique name of the job registered by Timer.AddUnique, empty if not named.
 }
 
 // JobFunc is the timing called job function in timer.
@@ -30,6 +34,13 @@ func (entry *Entry) Status() int {
 
 // Run runs the timer job asynchronously.
 func (entry *Entry) Run() {
+	// The timer lock makes sure no job starts after the timer is closed,
+	// so that Timer.CloseGracefully can safely wait for the running ones.
+	entry.timer.mu.RLock()
+	defer entry.timer.mu.RUnlock()
+	if entry.timer.status.Val() == StatusClosed {
+		return
+	}
 	if !entry.infinite.Val() {
 		leftRunningTimes := entry.times.Add(-1)
 		// It checks its running times exceeding.
@@ -38,19 +49,27 @@ func (entry *Entry) Run() {
 			return
 		}
 	}
+	entry.timer.running.Add(1)
+	entry.timer.fired.Add(1)
 	go entry.callJobFunc()
 }
 
 // callJobFunc executes the job function in entry.
 func (entry *Entry) callJobFunc() {
+	defer entry.timer.running.Done()
 	defer func() {
 		if exception := recover(); exception != nil {
 			if exception != panicExit {
+				// The panic is recovered and reported instead of being re-panicked,
+				// as re-panicking in the job goroutine would crash the whole process.
+				entry.timer.panicked.Add(1)
+				var err error
 				if v, ok := exception.(error); ok && gerror.HasStack(v) {
-					panic(v)
+					err = v
 				} else {
-					panic(gerror.NewCodef(gcode.CodeInternalPanic, "exception recovered: %+v", exception))
+					err = gerror.NewCodef(gcode.CodeInternalPanic, "exception recovered: %+v", exception)
 				}
+				entry.timer.handlePanic(entry.ctx, err)
 			} else {
 				entry.Close()
 				return
@@ -71,7 +90,7 @@ func (entry *Entry) doCheckAndRunByTicks(currentTimerTicks int64) {
 	if currentTimerTicks < entry.nextTicks.Val() {
 		return
 	}
-	entry.nextTicks.Set(currentTimerTicks + entry.ticks)
+	entry.nextTicks.Set(currentTimerTicks + entry.intervalTicks())
 	// Perform job checking.
 	switch entry.status.Val() {
 	case StatusRunning:
@@ -91,6 +110,23 @@ func (entry *Entry) doCheckAndRunByTicks(currentTimerTicks int64) {
 	entry.Run()
 }
 
+// intervalTicks returns the ticks to wait for the next run of the job.
+// If the job has jitter, the interval is randomized by up to `jitter` for each call.
+func (entry *Entry) intervalTicks() int64 {
+	if entry.jitter <= 0 {
+		return entry.ticks
+	}
+	var (
+		timerInterval = entry.timer.options.Interval
+		delay         = time.Duration(entry.ticks)*timerInterval + grand.D(-entry.jitter, entry.jitter)
+		ticks         = int64(delay / timerInterval)
+	)
+	if ticks < 1 {
+		ticks = 1
+	}
+	return ticks
+}
+
 // SetStatus custom sets the status for the job.
 func (entry *Entry) SetStatus(status int) int {
 	return entry.status.Set(status)
@@ -109,6 +145,9 @@ func (entry *Entry) Stop() {
 // Close closes the job, and then it will be removed from the timer.
 func (entry *Entry) Close() {
 	entry.status.Set(StatusClosed)
+	if entry.name != "" {
+		entry.timer.removeName(entry)
+	}
 }
 
 // Reset resets the job, which resets its ticks for next running.
@@ -43,6 +43,13 @@ func (q *priorityQueue) NextPriority() int64 {
 	return q.nextPriority.Val()
 }
 
+// Len returns the count of values in the queue.
+func (q *priorityQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.heap.array)
+}
+
 // Push pushes a value to the queue.
 // The `priority` specifies the priority of the value.
 // The lesser the `priority` value the higher priority of the `value`.
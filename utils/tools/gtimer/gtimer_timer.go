@@ -3,15 +3,18 @@ package gtimer
 import (
 	"context"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtype"
+	"log"
 	"time"
 )
 
 // New creates and returns a Timer.
 func New(options ...TimerOptions) *Timer {
 	t := &Timer{
-		queue:  newPriorityQueue(),
-		status: gtype.NewInt(StatusRunning),
-		ticks:  gtype.NewInt64(),
+		queue:    newPriorityQueue(),
+		status:   gtype.NewInt(StatusRunning),
+		ticks:    gtype.NewInt64(),
+		fired:    gtype.NewInt64(),
+		panicked: gtype.NewInt64(),
 	}
 	if len(options) > 0 {
 		t.options = options[0]
@@ -37,6 +40,81 @@ func (t *Timer) Add(ctx context.Context, interval time.Duration, job JobFunc) *E
 	})
 }
 
+// AddWithJitter adds a timing job to the timer, which runs in interval of `interval`
+// randomized by up to `maxJitter` in both directions. The jitter is recomputed for each run,
+// which avoids many jobs with the same interval running at exactly the same time.
+func (t *Timer) AddWithJitter(ctx context.Context, interval, maxJitter time.Duration, job JobFunc) *Entry {
+	return t.createEntry(createEntryInput{
+		Ctx:         ctx,
+		Interval:    interval,
+		Job:         job,
+		IsSingleton: false,
+		Times:       -1,
+		Status:      StatusReady,
+		Jitter:      maxJitter,
+	})
+}
+
+// AddCancelable adds a timing job to the timer like Add, and returns the entry along with a cancel function.
+// Calling the cancel function closes the entry and cancels the context passed to the job, so that
+// in-flight runs observing `ctx.Done()` can abort cooperatively and no further runs occur.
+// The entry is also closed on its next run after the parent context `ctx` is done.
+func (t *Timer) AddCancelable(ctx context.Context, interval time.Duration, job JobFunc) (*Entry, context.CancelFunc) {
+	var (
+		entry          *Entry
+		ready          = make(chan struct{}) // ready is closed once entry is assigned, as the job may run before Add returns.
+		jobCtx, cancel = context.WithCancel(ctx)
+	)
+	entry = t.Add(jobCtx, interval, func(ctx context.Context) {
+		<-ready
+		if ctx.Err() != nil {
+			entry.Close()
+			return
+		}
+		job(ctx)
+	})
+	close(ready)
+	return entry, func() {
+		entry.Close()
+		cancel()
+	}
+}
+
+// AddUnique adds a timing job named `name` to the timer, which runs in interval of `interval`,
+// only if no other job with the same name is registered. It returns the new entry and true,
+// or the existing entry and false if the name is already taken.
+// The name is freed when the entry is closed, so it can be registered again afterwards.
+func (t *Timer) AddUnique(ctx context.Context, name string, interval time.Duration, job JobFunc) (*Entry, bool) {
+	t.namesMu.Lock()
+	defer t.namesMu.Unlock()
+	if entry, ok := t.names[name]; ok && entry.Status() != StatusClosed {
+		return entry, false
+	}
+	if t.names == nil {
+		t.names = make(map[string]*Entry)
+	}
+	entry := t.createEntry(createEntryInput{
+		Ctx:         ctx,
+		Interval:    interval,
+		Job:         job,
+		IsSingleton: false,
+		Times:       -1,
+		Status:      StatusReady,
+		Name:        name,
+	})
+	t.names[name] = entry
+	return entry, true
+}
+
+// removeName frees the name of `entry` from the registry of named jobs.
+func (t *Timer) removeName(entry *Entry) {
+	t.namesMu.Lock()
+	defer t.namesMu.Unlock()
+	if t.names[entry.name] == entry {
+		delete(t.names, entry.name)
+	}
+}
+
 // AddEntry adds a timing job to the timer with detailed parameters.
 //
 // The parameter `interval` specifies the running interval of the job.
@@ -135,6 +213,27 @@ func (t *Timer) DelayAddTimes(ctx context.Context, delay time.Duration, interval
 	})
 }
 
+// Metrics returns the snapshot of the scheduling statistics of the timer.
+// It only reads atomic counters and the queue length, which is cheap to call.
+func (t *Timer) Metrics() TimerMetrics {
+	return TimerMetrics{
+		QueueLength:  t.queue.Len(),
+		TotalFired:   t.fired.Val(),
+		PanickedJobs: t.panicked.Val(),
+		Ticks:        t.ticks.Val(),
+	}
+}
+
+// handlePanic reports the error recovered from a panicking job to the PanicHandler of the timer,
+// or writes it to the standard logger if no handler is configured.
+func (t *Timer) handlePanic(ctx context.Context, err error) {
+	if t.options.PanicHandler != nil {
+		t.options.PanicHandler(ctx, err)
+		return
+	}
+	log.Printf("gtimer: job panicked: %+v", err)
+}
+
 // Start starts the timer.
 func (t *Timer) Start() {
 	t.status.Set(StatusRunning)
@@ -145,9 +244,30 @@ func (t *Timer) Stop() {
 	t.status.Set(StatusStopped)
 }
 
-// Close closes the timer.
+// Close closes the timer, which stops scheduling new runs of its jobs.
+// Jobs that are already executing are not interrupted.
 func (t *Timer) Close() {
+	t.mu.Lock()
 	t.status.Set(StatusClosed)
+	t.mu.Unlock()
+}
+
+// CloseGracefully closes the timer like Close, and then blocks until all currently
+// executing jobs finish or `ctx` is done. It returns the error of `ctx` if it expires
+// before the running jobs finish.
+func (t *Timer) CloseGracefully(ctx context.Context) error {
+	t.Close()
+	done := make(chan struct{})
+	go func() {
+		t.running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 type createEntryInput struct {
@@ -157,6 +277,8 @@ type createEntryInput struct {
 	IsSingleton bool
 	Times       int
 	Status      int
+	Jitter      time.Duration
+	Name        string
 }
 
 // createEntry creates and adds a timing job to the timer.
@@ -194,8 +316,14 @@ func (t *Timer) createEntry(in createEntryInput) *Entry {
 			isSingleton: gtype.NewBool(in.IsSingleton),
 			nextTicks:   gtype.NewInt64(nextTicks),
 			infinite:    gtype.NewBool(infinite),
+			jitter:      in.Jitter,
+			name:        in.Name,
 		}
 	)
+	if !t.options.Quick && entry.jitter > 0 {
+		nextTicks = t.ticks.Val() + entry.intervalTicks()
+		entry.nextTicks.Set(nextTicks)
+	}
 	t.queue.Push(entry, nextTicks)
 	return entry
 }
@@ -0,0 +1,81 @@
+package gtimer_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+)
+
+func TestTimer_AddCancelable(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	var (
+		runs    int32
+		aborted = make(chan struct{})
+	)
+	entry, cancel := timer.AddCancelable(context.Background(), 10*time.Millisecond, func(ctx context.Context) {
+		if atomic.AddInt32(&runs, 1) == 1 {
+			<-ctx.Done()
+			close(aborted)
+		}
+	})
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("in-flight job did not observe the cancellation")
+	}
+	if entry.Status() != gtimer.StatusClosed {
+		t.Errorf("Status = %d, want StatusClosed", entry.Status())
+	}
+	n := atomic.LoadInt32(&runs)
+	time.Sleep(50 * time.Millisecond)
+	if atomic.LoadInt32(&runs) != n {
+		t.Error("job ran after cancel")
+	}
+}
+
+func TestTimer_AddCancelable_ParentContext(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	var (
+		runs        int32
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+	entry, _ := timer.AddCancelable(ctx, 10*time.Millisecond, func(ctx context.Context) {
+		atomic.AddInt32(&runs, 1)
+	})
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+
+	if entry.Status() != gtimer.StatusClosed {
+		t.Errorf("Status = %d, want StatusClosed", entry.Status())
+	}
+	if atomic.LoadInt32(&runs) == 0 {
+		t.Error("job never ran")
+	}
+}
+
+func TestTimer_AddCancelable_Quick(t *testing.T) {
+	// In quick mode the job may run before AddCancelable returns.
+	timer := gtimer.New(gtimer.TimerOptions{Interval: time.Millisecond, Quick: true})
+	defer timer.Close()
+	for i := 0; i < 50; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		entry, _ := timer.AddCancelable(ctx, time.Millisecond, func(ctx context.Context) {})
+		// Wait for the first run, which closes the entry, without relying on scheduling latency.
+		for deadline := time.Now().Add(time.Second); entry.Status() != gtimer.StatusClosed && time.Now().Before(deadline); {
+			time.Sleep(time.Millisecond)
+		}
+		if entry.Status() != gtimer.StatusClosed {
+			t.Fatalf("Status = %d, want StatusClosed", entry.Status())
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package gtimer_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+)
+
+func TestTimer_CloseGracefully(t *testing.T) {
+	var (
+		timer    = gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+		started  = make(chan struct{}, 100)
+		calls    int32
+		finished int32
+	)
+	timer.Add(context.Background(), 10*time.Millisecond, func(ctx context.Context) {
+		atomic.AddInt32(&calls, 1)
+		started <- struct{}{}
+		time.Sleep(100 * time.Millisecond)
+		atomic.AddInt32(&finished, 1)
+	})
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("job did not start")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := timer.CloseGracefully(ctx); err != nil {
+		t.Fatalf("CloseGracefully = %v", err)
+	}
+	closedCalls := atomic.LoadInt32(&calls)
+	if got := atomic.LoadInt32(&finished); got != closedCalls {
+		t.Errorf("CloseGracefully returned with %d of %d runs finished", got, closedCalls)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != closedCalls {
+		t.Errorf("job ran %d more times after CloseGracefully returned", got-closedCalls)
+	}
+}
+
+func TestTimer_CloseGracefully_Timeout(t *testing.T) {
+	var (
+		timer   = gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+		started = make(chan struct{}, 1)
+		release = make(chan struct{})
+	)
+	defer close(release)
+	timer.AddOnce(context.Background(), 10*time.Millisecond, func(ctx context.Context) {
+		started <- struct{}{}
+		<-release
+	})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := timer.CloseGracefully(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("CloseGracefully = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestTimer_CloseGracefully_Idle(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	timer.Add(context.Background(), time.Hour, func(ctx context.Context) {})
+	if err := timer.CloseGracefully(context.Background()); err != nil {
+		t.Errorf("CloseGracefully = %v, want nil", err)
+	}
+}
@@ -0,0 +1,82 @@
+package gtimer_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+)
+
+func TestTimer_AddWithJitter(t *testing.T) {
+	const (
+		interval  = 100 * time.Millisecond
+		maxJitter = 50 * time.Millisecond
+		// slack covers the timer tick granularity and scheduling delays.
+		slack = 30 * time.Millisecond
+	)
+	var (
+		timer = gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+		mu    sync.Mutex
+		runs  []time.Time
+		start = time.Now()
+	)
+	defer timer.Close()
+	timer.AddWithJitter(context.Background(), interval, maxJitter, func(ctx context.Context) {
+		mu.Lock()
+		runs = append(runs, time.Now())
+		mu.Unlock()
+	})
+	time.Sleep(1500 * time.Millisecond)
+	timer.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(runs) < 8 {
+		t.Fatalf("job ran %d times in 1.5s, want at least 8", len(runs))
+	}
+	var (
+		minGap = time.Hour
+		maxGap time.Duration
+		prev   = start
+	)
+	for i, run := range runs {
+		gap := run.Sub(prev)
+		prev = run
+		if gap < interval-maxJitter-slack || gap > interval+maxJitter+slack {
+			t.Errorf("run %d came %v after the previous one, want within [%v, %v]",
+				i, gap, interval-maxJitter, interval+maxJitter)
+		}
+		if gap < minGap {
+			minGap = gap
+		}
+		if gap > maxGap {
+			maxGap = gap
+		}
+	}
+	// The jitter is recomputed for each run, so the gaps should not all be the same.
+	if maxGap-minGap < 20*time.Millisecond {
+		t.Errorf("gaps ranged from %v to %v, want them to vary with the jitter", minGap, maxGap)
+	}
+}
+
+func TestTimer_AddWithJitter_Zero(t *testing.T) {
+	var (
+		timer = gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+		mu    sync.Mutex
+		runs  int
+	)
+	defer timer.Close()
+	timer.AddWithJitter(context.Background(), 50*time.Millisecond, 0, func(ctx context.Context) {
+		mu.Lock()
+		runs++
+		mu.Unlock()
+	})
+	time.Sleep(280 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	if runs < 4 || runs > 6 {
+		t.Errorf("job ran %d times, want about 5 without jitter", runs)
+	}
+}
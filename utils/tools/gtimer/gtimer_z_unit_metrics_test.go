@@ -0,0 +1,90 @@
+package gtimer_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+)
+
+func TestTimer_Metrics(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	var runs int32
+	timer.Add(context.Background(), 10*time.Millisecond, func(ctx context.Context) {
+		atomic.AddInt32(&runs, 1)
+	})
+	timer.Add(context.Background(), time.Hour, func(ctx context.Context) {})
+	time.Sleep(200 * time.Millisecond)
+	// A run is counted as fired before its goroutine executes, so let in-flight runs finish first.
+	timer.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	metrics := timer.Metrics()
+	if metrics.QueueLength != 2 {
+		t.Errorf("QueueLength = %d, want 2", metrics.QueueLength)
+	}
+	if metrics.TotalFired < 1 || metrics.TotalFired != int64(atomic.LoadInt32(&runs)) {
+		t.Errorf("TotalFired = %d, runs = %d", metrics.TotalFired, atomic.LoadInt32(&runs))
+	}
+	if metrics.PanickedJobs != 0 {
+		t.Errorf("PanickedJobs = %d, want 0", metrics.PanickedJobs)
+	}
+	if metrics.Ticks < 1 {
+		t.Errorf("Ticks = %d, want > 0", metrics.Ticks)
+	}
+}
+
+func TestTimer_Metrics_PanickedJobs(t *testing.T) {
+	var (
+		errs  = make(chan error, 10)
+		timer = gtimer.New(gtimer.TimerOptions{
+			Interval: 10 * time.Millisecond,
+			PanicHandler: func(ctx context.Context, err error) {
+				errs <- err
+			},
+		})
+	)
+	defer timer.Close()
+	timer.AddTimes(context.Background(), 10*time.Millisecond, 2, func(ctx context.Context) {
+		panic("boom")
+	})
+
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errs:
+			if gerror.Code(err) != gcode.CodeInternalPanic {
+				t.Errorf("code = %v, want CodeInternalPanic", gerror.Code(err))
+			}
+		case <-time.After(time.Second):
+			t.Fatal("panic handler was not called")
+		}
+	}
+	if n := timer.Metrics().PanickedJobs; n != 2 {
+		t.Errorf("PanickedJobs = %d, want 2", n)
+	}
+}
+
+func TestTimer_PanicWithoutHandler(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	var runs int32
+	timer.Add(context.Background(), 10*time.Millisecond, func(ctx context.Context) {
+		if atomic.AddInt32(&runs, 1) == 1 {
+			panic("boom")
+		}
+	})
+	time.Sleep(200 * time.Millisecond)
+
+	// The job keeps being scheduled after a recovered panic.
+	if n := atomic.LoadInt32(&runs); n < 2 {
+		t.Errorf("runs = %d, want >= 2", n)
+	}
+	if n := timer.Metrics().PanickedJobs; n != 1 {
+		t.Errorf("PanickedJobs = %d, want 1", n)
+	}
+}
@@ -0,0 +1,85 @@
+package gtimer_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gtimer"
+)
+
+func TestTimer_AddUnique(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	var first, second int32
+	entry1, ok1 := timer.AddUnique(context.Background(), "cleanup", 10*time.Millisecond, func(ctx context.Context) {
+		atomic.AddInt32(&first, 1)
+	})
+	entry2, ok2 := timer.AddUnique(context.Background(), "cleanup", 10*time.Millisecond, func(ctx context.Context) {
+		atomic.AddInt32(&second, 1)
+	})
+	if !ok1 || ok2 {
+		t.Errorf("AddUnique ok = %v, %v, want true, false", ok1, ok2)
+	}
+	if entry2 != entry1 {
+		t.Error("the second AddUnique should return the first entry")
+	}
+	if _, ok := timer.AddUnique(context.Background(), "other", time.Hour, func(ctx context.Context) {}); !ok {
+		t.Error("a different name should be registered")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if atomic.LoadInt32(&first) == 0 || atomic.LoadInt32(&second) != 0 {
+		t.Errorf("runs = %d, %d, want only the first job running", first, second)
+	}
+}
+
+func TestTimer_AddUnique_Close(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	job := func(ctx context.Context) {}
+	entry1, _ := timer.AddUnique(context.Background(), "job", time.Hour, job)
+	entry1.Close()
+
+	entry2, ok := timer.AddUnique(context.Background(), "job", time.Hour, job)
+	if !ok || entry2 == entry1 {
+		t.Error("the name should be freed after the entry is closed")
+	}
+	// Closing the stale entry again must not free the name of the new one.
+	entry1.Close()
+	if entry3, ok := timer.AddUnique(context.Background(), "job", time.Hour, job); ok || entry3 != entry2 {
+		t.Error("closing a stale entry should not free the name of the registered one")
+	}
+}
+
+func TestTimer_AddUnique_Concurrent(t *testing.T) {
+	timer := gtimer.New(gtimer.TimerOptions{Interval: 10 * time.Millisecond})
+	defer timer.Close()
+	var (
+		wg      sync.WaitGroup
+		added   int32
+		entries sync.Map
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			entry, ok := timer.AddUnique(context.Background(), "job", time.Hour, func(ctx context.Context) {})
+			if ok {
+				atomic.AddInt32(&added, 1)
+			}
+			entries.Store(entry, struct{}{})
+		}()
+	}
+	wg.Wait()
+	count := 0
+	entries.Range(func(key, value interface{}) bool {
+		count++
+		return true
+	})
+	if added != 1 || count != 1 {
+		t.Errorf("added = %d, distinct entries = %d, want 1 and 1", added, count)
+	}
+}
@@ -1,7 +1,12 @@
 package gutil
 
 import (
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/empty"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gconv"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -118,3 +123,68 @@ func ComparatorTime(a, b interface{}) int {
 		return 0
 	}
 }
+
+// ComparatorDesc 返回一个与 `c` 比较结果相反的比较器，可用于降序排序。
+func ComparatorDesc(c Comparator) Comparator {
+	return func(a, b interface{}) int {
+		return c(b, a)
+	}
+}
+
+// ComparatorNilFirst 返回一个对 nil 友好的比较器，nil 值（包括 nil 指针）排在非 nil 值之前，
+// 两者都不为 nil 时交由 `c` 进行比较。
+func ComparatorNilFirst(c Comparator) Comparator {
+	return func(a, b interface{}) int {
+		return compareNil(a, b, c, -1)
+	}
+}
+
+// ComparatorNilLast 返回一个对 nil 友好的比较器，nil 值（包括 nil 指针）排在非 nil 值之后，
+// 两者都不为 nil 时交由 `c` 进行比较。
+func ComparatorNilLast(c Comparator) Comparator {
+	return func(a, b interface{}) int {
+		return compareNil(a, b, c, 1)
+	}
+}
+
+// compareNil 根据 `nilOrder` 比较可能为 nil 的 `a` 和 `b`，
+// `nilOrder` 为负数表示 nil 排在前面，为正数表示 nil 排在后面。
+func compareNil(a, b interface{}, c Comparator, nilOrder int) int {
+	var (
+		aNil = empty.IsNil(a)
+		bNil = empty.IsNil(b)
+	)
+	switch {
+	case aNil && bNil:
+		return 0
+	case aNil:
+		return nilOrder
+	case bNil:
+		return -nilOrder
+	default:
+		return c(a, b)
+	}
+}
+
+// SortSlice 使用 `comparator` 对任意类型的切片 `slice` 进行原地稳定排序。
+// 参数 `slice` 可以是切片或者指向切片的指针，其他类型将会引发 panic。
+//
+// 例如：
+// SortSlice([]int{3, 1, 2}, ComparatorInt)                 => [1, 2, 3]
+// SortSlice([]int{3, 1, 2}, ComparatorDesc(ComparatorInt)) => [3, 2, 1]
+func SortSlice(slice interface{}, comparator Comparator) {
+	reflectValue := reflect.ValueOf(slice)
+	for reflectValue.Kind() == reflect.Ptr {
+		reflectValue = reflectValue.Elem()
+	}
+	if reflectValue.Kind() != reflect.Slice {
+		panic(gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid parameter type "%T", should be type of slice or pointer of slice`,
+			slice,
+		))
+	}
+	sort.SliceStable(reflectValue.Interface(), func(i, j int) bool {
+		return comparator(reflectValue.Index(i).Interface(), reflectValue.Index(j).Interface()) < 0
+	})
+}
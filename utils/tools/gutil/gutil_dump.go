@@ -27,6 +27,21 @@ type iMarshalJSON interface {
 	MarshalJSON() ([]byte, error)
 }
 
+// iMapStrAny is the interface for containers that can be converted to map[string]interface{}, like gmap.
+type iMapStrAny interface {
+	MapStrAny() map[string]interface{}
+}
+
+// iFrontAll is the interface for list containers that return all their values, like glist.
+type iFrontAll interface {
+	FrontAll() []interface{}
+}
+
+// iVal is the interface for value wrappers like gvar, which are not dumped as containers.
+type iVal interface {
+	Val() interface{}
+}
+
 // DumpOption specifies the behavior of function Export.
 type DumpOption struct {
 	WithType     bool // WithType specifies dumping content with type information.
@@ -64,6 +79,17 @@ func DumpWithOption(value interface{}, option DumpOption) {
 	fmt.Println(buffer.String())
 }
 
+// Export returns variable `value` as a string with more manually readable, which is the same as Dump prints.
+func Export(value interface{}, option ...DumpOption) string {
+	var dumpOption DumpOption
+	if len(option) > 0 {
+		dumpOption = option[0]
+	}
+	buffer := bytes.NewBuffer(nil)
+	DumpTo(buffer, value, dumpOption)
+	return buffer.String()
+}
+
 // DumpTo writes variables `values` as a string in to `writer` with more manually readable
 func DumpTo(writer io.Writer, value interface{}, option DumpOption) {
 	buffer := bytes.NewBuffer(nil)
@@ -235,6 +261,15 @@ func doDumpSlice(in doDumpInternalInput) {
 }
 
 func doDumpMap(in doDumpInternalInput) {
+	// Guard against maps that contain themselves.
+	mapAddress := fmt.Sprintf(`map:0x%x`, in.ReflectValue.Pointer())
+	if _, ok := in.DumpedPointerSet[mapAddress]; ok {
+		in.Buffer.WriteString(fmt.Sprintf(`<cycle dump %s>`, mapAddress[4:]))
+		return
+	}
+	in.DumpedPointerSet[mapAddress] = struct{}{}
+	defer delete(in.DumpedPointerSet, mapAddress)
+
 	var mapKeys = make([]reflect.Value, 0)
 	for _, key := range in.ReflectValue.MapKeys() {
 		if !key.CanInterface() {
@@ -322,6 +357,11 @@ func doDumpStruct(in doDumpInternalInput) {
 		}
 	}
 	if !isReflectValue && (len(structFields) == 0 || hasNoExportedFields) {
+		// Containers like gmap, gset and glist are dumped as their underlying data.
+		if content, ok := dumpContainerContent(in.Value); ok {
+			doDump(content, in.Indent, in.Buffer, in.Option)
+			return
+		}
 		var (
 			structContentStr  = ""
 			attributeCountStr = "0"
@@ -394,6 +434,26 @@ dumpStructFields:
 	in.Buffer.WriteString(fmt.Sprintf("%s}", in.Indent))
 }
 
+// dumpContainerContent returns the underlying data of container `value`, like gmap, gset and glist.
+func dumpContainerContent(value interface{}) (interface{}, bool) {
+	if _, ok := value.(iVal); ok {
+		return nil, false
+	}
+	switch v := value.(type) {
+	case iMapStrAny:
+		return v.MapStrAny(), true
+	case iFrontAll:
+		return v.FrontAll(), true
+	}
+	// Sets have Slice methods returning different slice types.
+	method := reflect.ValueOf(value).MethodByName("Slice")
+	if method.IsValid() && method.Type().NumIn() == 0 && method.Type().NumOut() == 1 &&
+		method.Type().Out(0).Kind() == reflect.Slice {
+		return method.Call(nil)[0].Interface(), true
+	}
+	return nil, false
+}
+
 func doDumpNumber(in doDumpInternalInput) {
 	if v, ok := in.Value.(iString); ok {
 		s := v.String()
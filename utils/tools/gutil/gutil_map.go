@@ -46,7 +46,9 @@ func MapMerge(dstMap map[string]interface{}, srcMaps ...map[string]interface{})
 	}
 }
 
-// MapMergeCopy creates and returns a new map which merges all map from `src`.
+// MapMergeCopy creates and returns a new map which shallowly merges all maps from `maps`.
+// The maps are merged in order, so for duplicated keys the value from the last map wins.
+// None of the given maps is modified.
 func MapMergeCopy(maps ...map[string]interface{}) (copy map[string]interface{}) {
 	copy = make(map[string]interface{})
 	for _, m := range maps {
@@ -106,3 +108,48 @@ func MapToSlice(data interface{}) []interface{} {
 	}
 	return nil
 }
+
+// MapKeys retrieves and returns the keys of any map type `data` as a slice, keeping their original types.
+// Unlike Keys, the keys are not converted to string. The order of the result is not deterministic.
+// It returns nil if `data` is not a map or a pointer to map.
+// Eg: map[int]string{1: "a", 2: "b"} => [1, 2]
+func MapKeys(data interface{}) []interface{} {
+	reflectValue, ok := mapReflectValue(data)
+	if !ok {
+		return nil
+	}
+	keys := make([]interface{}, 0, reflectValue.Len())
+	for _, key := range reflectValue.MapKeys() {
+		keys = append(keys, key.Interface())
+	}
+	return keys
+}
+
+// MapValues retrieves and returns the values of any map type `data` as a slice.
+// The order of the result is not deterministic.
+// It returns nil if `data` is not a map or a pointer to map.
+// Eg: map[int]string{1: "a", 2: "b"} => ["a", "b"]
+func MapValues(data interface{}) []interface{} {
+	reflectValue, ok := mapReflectValue(data)
+	if !ok {
+		return nil
+	}
+	values := make([]interface{}, 0, reflectValue.Len())
+	iterator := reflectValue.MapRange()
+	for iterator.Next() {
+		values = append(values, iterator.Value().Interface())
+	}
+	return values
+}
+
+// mapReflectValue dereferences `data` and returns its reflect value if it is a map.
+func mapReflectValue(data interface{}) (reflect.Value, bool) {
+	reflectValue := reflect.ValueOf(data)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			return reflectValue, false
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	return reflectValue, reflectValue.Kind() == reflect.Map
+}
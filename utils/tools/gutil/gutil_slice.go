@@ -109,3 +109,35 @@ func SliceToMapWithColumnAsKey(slice interface{}, key interface{}) map[interface
 	}
 	return data
 }
+
+// SliceContains checks whether `value` exists in the slice or array `slice`, using reflect.DeepEqual.
+// It returns false if `slice` is nil or not a slice/array.
+func SliceContains(slice interface{}, value interface{}) bool {
+	return SliceIndexOf(slice, value) != -1
+}
+
+// SliceIndexOf returns the index of the first element in the slice or array `slice`
+// that equals to `value` using reflect.DeepEqual, or -1 if not found.
+// It returns -1 if `slice` is nil or not a slice/array.
+func SliceIndexOf(slice interface{}, value interface{}) int {
+	if slice == nil {
+		return -1
+	}
+	var (
+		reflectValue = reflect.ValueOf(slice)
+		reflectKind  = reflectValue.Kind()
+	)
+	for reflectKind == reflect.Ptr {
+		reflectValue = reflectValue.Elem()
+		reflectKind = reflectValue.Kind()
+	}
+	switch reflectKind {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < reflectValue.Len(); i++ {
+			if reflect.DeepEqual(reflectValue.Index(i).Interface(), value) {
+				return i
+			}
+		}
+	}
+	return -1
+}
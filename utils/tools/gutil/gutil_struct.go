@@ -101,3 +101,44 @@ func FillStructWithDefault(structPtr interface{}) error {
 
 	return nil
 }
+
+// StructToMap converts struct `v` to map[string]interface{}, only exported attributes are converted.
+// The optional parameter `tag` specifies the struct tag used as the map key, eg: json.
+// It checks the gconv and json tags in order if no `tag` given, and uses the attribute name if no tag found.
+// Nested struct and pointer attributes are converted to maps recursively.
+// It returns nil if `v` is not a struct or pointer of struct.
+func StructToMap(v interface{}, tag ...string) map[string]interface{} {
+	if v == nil {
+		return nil
+	}
+	reflectValue := reflect.ValueOf(v)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			return nil
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	if reflectValue.Kind() != reflect.Struct {
+		return nil
+	}
+	return gconv.Map(v, gconv.MapOption{
+		Deep: true,
+		Tags: tag,
+	})
+}
+
+// MapToStruct fills the struct that `pointer` points to with map `m`.
+// The values are converted to the attribute types automatically using gconv,
+// and nested struct and pointer attributes are filled recursively.
+// The parameter `pointer` should be type of *struct.
+func MapToStruct(m map[string]interface{}, pointer interface{}) error {
+	reflectValue := reflect.ValueOf(pointer)
+	if reflectValue.Kind() != reflect.Ptr || reflectValue.IsNil() || reflectValue.Elem().Kind() != reflect.Struct {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid parameter "%T", should be type of pointer of struct`,
+			pointer,
+		)
+	}
+	return gconv.Struct(m, pointer)
+}
@@ -2,6 +2,9 @@ package gutil
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
+
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
 	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
 )
@@ -13,16 +16,28 @@ func Throw(exception interface{}) {
 
 // Try implements try... logistics using internal panic...recover.
 // It returns error if any exception occurs, or else it returns nil.
+//
+// The returned error is a gerror error with code gcode.CodeInternalPanic, unless the panic value
+// is already an error implementing gerror.IStack, which is returned as it is. Any other panic error
+// is wrapped, so that it can still be matched with errors.Is and errors.As.
+//
+// The stack of the panicking goroutine is captured when the panic is recovered. It can be retrieved
+// by gerror.Stack, and is also printed after the error message when formatted with "%+v".
 func Try(ctx context.Context, try func(ctx context.Context)) (err error) {
 	if try == nil {
 		return
 	}
 	defer func() {
 		if exception := recover(); exception != nil {
-			if v, ok := exception.(error); ok && gerror.HasStack(v) {
-				err = v
+			stack := string(debug.Stack())
+			if v, ok := exception.(error); ok {
+				if gerror.HasStack(v) {
+					err = v
+				} else {
+					err = &panicError{err: gerror.WrapCode(gcode.CodeInternalPanic, v, "exception recovered"), stack: stack}
+				}
 			} else {
-				err = gerror.NewCodef(gcode.CodeInternalPanic, "%+v", exception)
+				err = &panicError{err: gerror.NewCodef(gcode.CodeInternalPanic, "%+v", exception), stack: stack}
 			}
 		}
 	}()
@@ -30,6 +45,44 @@ func Try(ctx context.Context, try func(ctx context.Context)) (err error) {
 	return
 }
 
+// panicError is the error returned by Try, which carries the stack of the recovered panic.
+type panicError struct {
+	err   error  // err is the error created from the panic value.
+	stack string // stack is the stack of the goroutine at the time of the panic.
+}
+
+// Error implements the interface of Error, it returns the message of the panic error.
+func (e *panicError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error created from the panic value, which holds the code and the original error.
+func (e *panicError) Unwrap() error {
+	return e.err
+}
+
+// Stack returns the stack of the goroutine at the time of the panic.
+func (e *panicError) Stack() string {
+	return e.stack
+}
+
+// Format formats the error according to fmt.Formatter.
+// The verb "%+v" prints the error message followed by the stack of the panic.
+func (e *panicError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			_, _ = fmt.Fprintf(s, "%s\n%s", e.Error(), e.stack)
+			return
+		}
+		_, _ = fmt.Fprint(s, e.Error())
+	case 's':
+		_, _ = fmt.Fprint(s, e.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
 // TryCatch implements `try...catch..`. logistics using internal `panic...recover`.
 // It automatically calls function `catch` if any exception occurs and passes the exception as an error.
 // If `catch` is given nil, it ignores the panic from `try` and no panic will throw to parent goroutine.
@@ -0,0 +1,97 @@
+package gutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gutil"
+)
+
+func TestSortSlice(t *testing.T) {
+	ints := []int{3, 1, 2, 5, 4}
+	gutil.SortSlice(ints, gutil.ComparatorInt)
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(ints, want) {
+		t.Errorf("ascending = %v, want %v", ints, want)
+	}
+
+	gutil.SortSlice(&ints, gutil.ComparatorDesc(gutil.ComparatorInt))
+	if want := []int{5, 4, 3, 2, 1}; !reflect.DeepEqual(ints, want) {
+		t.Errorf("descending = %v, want %v", ints, want)
+	}
+
+	strs := []string{"pear", "apple", "fig"}
+	gutil.SortSlice(strs, gutil.ComparatorString)
+	if want := []string{"apple", "fig", "pear"}; !reflect.DeepEqual(strs, want) {
+		t.Errorf("strings = %v, want %v", strs, want)
+	}
+}
+
+func TestSortSlice_Stable(t *testing.T) {
+	type item struct {
+		Key   int
+		Order int
+	}
+	items := []item{{2, 0}, {1, 1}, {2, 2}, {1, 3}}
+	gutil.SortSlice(items, func(a, b interface{}) int {
+		return gutil.ComparatorInt(a.(item).Key, b.(item).Key)
+	})
+	want := []item{{1, 1}, {1, 3}, {2, 0}, {2, 2}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("stable sort = %v, want %v", items, want)
+	}
+}
+
+func TestSortSlice_InvalidParameter(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("sorting a non-slice should panic")
+		}
+	}()
+	gutil.SortSlice(1, gutil.ComparatorInt)
+}
+
+func TestComparatorNilFirstLast(t *testing.T) {
+	compareIntPtr := func(a, b interface{}) int {
+		return gutil.ComparatorInt(*a.(*int), *b.(*int))
+	}
+	var (
+		one   = 1
+		two   = 2
+		three = 3
+		nilP  *int
+	)
+	values := func() []interface{} {
+		return []interface{}{&three, nil, &one, nilP, &two}
+	}
+	deref := func(values []interface{}) []interface{} {
+		result := make([]interface{}, len(values))
+		for i, v := range values {
+			if p, ok := v.(*int); ok && p != nil {
+				result[i] = *p
+			}
+		}
+		return result
+	}
+
+	first := values()
+	gutil.SortSlice(first, gutil.ComparatorNilFirst(compareIntPtr))
+	if got, want := deref(first), []interface{}{nil, nil, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("nil first = %v, want %v", got, want)
+	}
+
+	last := values()
+	gutil.SortSlice(last, gutil.ComparatorNilLast(compareIntPtr))
+	if got, want := deref(last), []interface{}{1, 2, 3, nil, nil}; !reflect.DeepEqual(got, want) {
+		t.Errorf("nil last = %v, want %v", got, want)
+	}
+
+	desc := values()
+	gutil.SortSlice(desc, gutil.ComparatorNilLast(gutil.ComparatorDesc(compareIntPtr)))
+	if got, want := deref(desc), []interface{}{3, 2, 1, nil, nil}; !reflect.DeepEqual(got, want) {
+		t.Errorf("nil last descending = %v, want %v", got, want)
+	}
+
+	if c := gutil.ComparatorNilFirst(compareIntPtr); c(nil, nilP) != 0 {
+		t.Error("two nil values should compare equal")
+	}
+}
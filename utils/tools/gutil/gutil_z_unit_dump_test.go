@@ -0,0 +1,76 @@
+package gutil_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/glist"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gmap"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gset"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gutil"
+)
+
+func TestExport(t *testing.T) {
+	value := map[string]interface{}{
+		"user": map[string]interface{}{
+			"tags": []interface{}{"a", 1},
+		},
+	}
+	want := `{
+    "user": {
+        "tags": [
+            "a",
+            1,
+        ],
+    },
+}`
+	if got := gutil.Export(value); got != want {
+		t.Errorf("Export = %s, want %s", got, want)
+	}
+
+	want = `map[string]interface {}(1) {
+    string("user"): map[string]interface {}(1) {
+        string("tags"): []interface {}(2) [
+            string(1) "a",
+            int(1),
+        ],
+    },
+}`
+	if got := gutil.Export(value, gutil.DumpOption{WithType: true}); got != want {
+		t.Errorf("Export with type = %s, want %s", got, want)
+	}
+
+	// Export returns the same content DumpTo writes.
+	var buffer bytes.Buffer
+	gutil.DumpTo(&buffer, value, gutil.DumpOption{})
+	if got := gutil.Export(value); got != buffer.String() {
+		t.Errorf("Export = %s, DumpTo = %s", got, buffer.String())
+	}
+}
+
+func TestExport_Containers(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{gmap.NewStrAnyMapFrom(map[string]interface{}{"k": 1}), "{\n    \"k\": 1,\n}"},
+		{gset.NewIntSetFrom([]int{5}), "[\n    5,\n]"},
+		{gset.NewStrSetFrom([]string{"x"}), "[\n    \"x\",\n]"},
+		{glist.NewFrom([]interface{}{1, "x"}), "[\n    1,\n    \"x\",\n]"},
+	}
+	for _, tt := range tests {
+		if got := gutil.Export(tt.value); got != tt.want {
+			t.Errorf("Export(%T) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestExport_Cycle(t *testing.T) {
+	m := map[string]interface{}{"a": 1}
+	m["self"] = m
+	got := gutil.Export(m)
+	if !strings.Contains(got, `"a":    1,`) || !strings.Contains(got, `"self": <cycle dump 0x`) {
+		t.Errorf("Export of a cyclic map = %s", got)
+	}
+}
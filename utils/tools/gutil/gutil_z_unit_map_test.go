@@ -0,0 +1,58 @@
+package gutil_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gutil"
+)
+
+func TestMapKeysValues(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c"}
+
+	keys := gutil.MapKeys(m)
+	ints := make([]int, 0, len(keys))
+	for _, k := range keys {
+		ints = append(ints, k.(int))
+	}
+	sort.Ints(ints)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(ints, want) {
+		t.Errorf("MapKeys = %v, want %v", ints, want)
+	}
+
+	values := gutil.MapValues(&m)
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		strs = append(strs, v.(string))
+	}
+	sort.Strings(strs)
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(strs, want) {
+		t.Errorf("MapValues = %v, want %v", strs, want)
+	}
+}
+
+func TestMapKeysValues_NonMap(t *testing.T) {
+	for _, data := range []interface{}{nil, 1, "map", []int{1, 2}} {
+		if keys := gutil.MapKeys(data); keys != nil {
+			t.Errorf("MapKeys(%v) = %v, want nil", data, keys)
+		}
+		if values := gutil.MapValues(data); values != nil {
+			t.Errorf("MapValues(%v) = %v, want nil", data, values)
+		}
+	}
+}
+
+func TestMapMergeCopy(t *testing.T) {
+	a := map[string]interface{}{"k1": 1, "k2": 2}
+	b := map[string]interface{}{"k2": 20, "k3": 30}
+	merged := gutil.MapMergeCopy(a, b)
+	want := map[string]interface{}{"k1": 1, "k2": 20, "k3": 30}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("MapMergeCopy = %v, want %v", merged, want)
+	}
+	// None of the given maps is modified.
+	if len(a) != 2 || a["k2"] != 2 || len(b) != 2 {
+		t.Errorf("source maps were modified: %v, %v", a, b)
+	}
+}
@@ -0,0 +1,44 @@
+package gutil_test
+
+import (
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gutil"
+)
+
+func TestSliceIndexOf(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	var (
+		ints    = []int{3, 1, 2, 1}
+		nilInts []int
+	)
+	tests := []struct {
+		slice interface{}
+		value interface{}
+		want  int
+	}{
+		{ints, 1, 1},
+		{ints, 5, -1},
+		{ints, int64(1), -1},
+		{&ints, 2, 2},
+		{[3]int{7, 8, 9}, 9, 2},
+		{[]string{"a", "b"}, "b", 1},
+		{[]string{"a", "b"}, "c", -1},
+		{[]point{{1, 2}, {3, 4}}, point{3, 4}, 1},
+		{[]point{{1, 2}, {3, 4}}, point{4, 3}, -1},
+		{[]interface{}{1, []int{1, 2}}, []int{1, 2}, 1},
+		{nilInts, 1, -1},
+		{nil, 1, -1},
+		{"abc", "a", -1},
+	}
+	for _, tt := range tests {
+		if got := gutil.SliceIndexOf(tt.slice, tt.value); got != tt.want {
+			t.Errorf("SliceIndexOf(%v, %v) = %d, want %d", tt.slice, tt.value, got, tt.want)
+		}
+		if got := gutil.SliceContains(tt.slice, tt.value); got != (tt.want != -1) {
+			t.Errorf("SliceContains(%v, %v) = %v, want %v", tt.slice, tt.value, got, tt.want != -1)
+		}
+	}
+}
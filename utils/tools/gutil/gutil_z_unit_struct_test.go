@@ -0,0 +1,101 @@
+package gutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gutil"
+)
+
+type structAddress struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip"`
+}
+
+type structUser struct {
+	Id      int            `json:"id"`
+	Name    string         `json:"name"`
+	Address structAddress  `json:"address"`
+	Backup  *structAddress `json:"backup"`
+	secret  string
+}
+
+func TestStructToMap(t *testing.T) {
+	user := &structUser{
+		Id:      1,
+		Name:    "john",
+		Address: structAddress{City: "Beijing", Zip: 100000},
+		Backup:  &structAddress{City: "Shanghai", Zip: 200000},
+		secret:  "hidden",
+	}
+	m := gutil.StructToMap(user, "json")
+	if m["id"] != 1 || m["name"] != "john" {
+		t.Errorf("StructToMap = %v, want id 1 and name john", m)
+	}
+	if _, ok := m["secret"]; ok {
+		t.Error("unexported attributes should be skipped")
+	}
+	want := map[string]interface{}{"city": "Beijing", "zip": 100000}
+	if !reflect.DeepEqual(m["address"], want) {
+		t.Errorf("address = %#v, want %#v", m["address"], want)
+	}
+	want = map[string]interface{}{"city": "Shanghai", "zip": 200000}
+	if !reflect.DeepEqual(m["backup"], want) {
+		t.Errorf("backup = %#v, want %#v", m["backup"], want)
+	}
+
+	if m = gutil.StructToMap(struct{ City string }{"Beijing"}); m["City"] != "Beijing" {
+		t.Errorf("StructToMap without tags = %v, want the attribute names as keys", m)
+	}
+	if m = gutil.StructToMap(map[string]int{"a": 1}); m != nil {
+		t.Errorf("StructToMap(map) = %v, want nil", m)
+	}
+	if m = gutil.StructToMap((*structUser)(nil)); m != nil {
+		t.Errorf("StructToMap(nil pointer) = %v, want nil", m)
+	}
+}
+
+func TestMapToStruct(t *testing.T) {
+	var user structUser
+	err := gutil.MapToStruct(map[string]interface{}{
+		"id":      "2",
+		"name":    "jane",
+		"address": map[string]interface{}{"city": "Beijing", "zip": "100000"},
+		"backup":  map[string]interface{}{"city": "Shanghai", "zip": 200000},
+	}, &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := structUser{
+		Id:      2,
+		Name:    "jane",
+		Address: structAddress{City: "Beijing", Zip: 100000},
+		Backup:  &structAddress{City: "Shanghai", Zip: 200000},
+	}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("MapToStruct = %+v, want %+v", user, want)
+	}
+
+	if err = gutil.MapToStruct(map[string]interface{}{}, user); err == nil {
+		t.Error("MapToStruct with a non-pointer should fail")
+	}
+	if err = gutil.MapToStruct(map[string]interface{}{}, (*structUser)(nil)); err == nil {
+		t.Error("MapToStruct with a nil pointer should fail")
+	}
+}
+
+func TestStructToMap_RoundTrip(t *testing.T) {
+	user := structUser{
+		Id:      3,
+		Name:    "bob",
+		Address: structAddress{City: "Hangzhou", Zip: 310000},
+		Backup:  &structAddress{City: "Suzhou", Zip: 215000},
+	}
+	var got structUser
+	if err := gutil.MapToStruct(gutil.StructToMap(user, "json"), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, user) {
+		t.Errorf("round trip = %+v, want %+v", got, user)
+	}
+}
@@ -0,0 +1,109 @@
+package gutil_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gcode"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gerror"
+	"github.com/dwrui/go-zero-admin/pkg/utils/tools/gutil"
+)
+
+// assertPanicStack checks that `err` carries the stack of the panic raised in this file.
+func assertPanicStack(t *testing.T, err error) {
+	t.Helper()
+	if stack := gerror.Stack(err); !strings.Contains(stack, "gutil_z_unit_try_catch_test.go") {
+		t.Errorf("gerror.Stack(err) = %q, want the stack of the panic", stack)
+	}
+	if s := fmt.Sprintf("%+v", err); !strings.HasPrefix(s, err.Error()+"\n") || !strings.Contains(s, "gutil_z_unit_try_catch_test.go") {
+		t.Errorf("%%+v = %q, want the message followed by the stack", s)
+	}
+}
+
+// stackError is an error implementing gerror.IStack.
+type stackError struct{}
+
+func (stackError) Error() string { return "stack error" }
+func (stackError) Stack() string { return "stack" }
+
+func TestTry(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		errTest = errors.New("test")
+	)
+	t.Run("no panic", func(t *testing.T) {
+		if err := gutil.Try(ctx, func(ctx context.Context) {}); err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+		if err := gutil.Try(ctx, nil); err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+	})
+	t.Run("panic with string", func(t *testing.T) {
+		err := gutil.Try(ctx, func(ctx context.Context) {
+			panic("boom")
+		})
+		if err == nil || err.Error() != "boom" {
+			t.Fatalf("err = %v, want boom", err)
+		}
+		if gerror.Code(err) != gcode.CodeInternalPanic {
+			t.Errorf("code = %v, want CodeInternalPanic", gerror.Code(err))
+		}
+		assertPanicStack(t, err)
+	})
+	t.Run("panic with error", func(t *testing.T) {
+		err := gutil.Try(ctx, func(ctx context.Context) {
+			panic(errTest)
+		})
+		if !errors.Is(err, errTest) {
+			t.Fatalf("err = %v, want wrapping %v", err, errTest)
+		}
+		if !strings.Contains(err.Error(), "test") {
+			t.Errorf("err = %q, want containing the panic message", err)
+		}
+		if gerror.Code(err) != gcode.CodeInternalPanic {
+			t.Errorf("code = %v, want CodeInternalPanic", gerror.Code(err))
+		}
+		assertPanicStack(t, err)
+	})
+	t.Run("panic with stack error", func(t *testing.T) {
+		err := gutil.Try(ctx, func(ctx context.Context) {
+			gutil.Throw(stackError{})
+		})
+		if _, ok := err.(stackError); !ok {
+			t.Errorf("err = %#v, want stackError returned as it is", err)
+		}
+	})
+}
+
+func TestTryCatch(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		caught error
+	)
+	gutil.TryCatch(ctx, func(ctx context.Context) {
+		panic("boom")
+	}, func(ctx context.Context, exception error) {
+		caught = exception
+	})
+	if caught == nil || caught.Error() != "boom" {
+		t.Errorf("caught = %v, want boom", caught)
+	}
+	assertPanicStack(t, caught)
+
+	caught = nil
+	gutil.TryCatch(ctx, func(ctx context.Context) {}, func(ctx context.Context, exception error) {
+		caught = exception
+	})
+	if caught != nil {
+		t.Errorf("caught = %v, want nil", caught)
+	}
+
+	// A nil catch ignores the panic.
+	gutil.TryCatch(ctx, func(ctx context.Context) {
+		panic("ignored")
+	}, nil)
+}